@@ -29,15 +29,19 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/binary"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"strings"
 	"time"
 
 	triton "nvidia_inferenceserver"
-
-	"google.golang.org/grpc"
+	"tritonclient"
 )
 
 const (
@@ -46,10 +50,46 @@ const (
 )
 
 type Flags struct {
-	ModelName    string
-	ModelVersion string
-	BatchSize    int
-	URL          string
+	ModelName        string
+	ModelVersion     string
+	BatchSize        int
+	URL              string
+	TLS              bool
+	TLSMinVersion    string
+	TLSCert          string
+	TLSKey           string
+	TLSCA            string
+	TLSServerName    string
+	Region           string
+	PollInterval     time.Duration
+	MaxWait          time.Duration
+	OutputCSV        string
+	MaxConcurrent    int
+	ModelCompression stringList
+	AuditLog         string
+	Quiet            bool
+	Timing           bool
+	TraceIDs         bool
+	Labels           string
+	TopK             int
+	Timeout          time.Duration
+	RequestID        string
+	Benchmark        time.Duration
+	Concurrency      int
+	BenchmarkJSON    bool
+	Output           string
+}
+
+// stringList collects the values of a repeated flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
 }
 
 func parseFlags() Flags {
@@ -58,184 +98,413 @@ func parseFlags() Flags {
 	flag.StringVar(&flags.ModelName, "m", "simple", "Name of model being served. (Required)")
 	flag.StringVar(&flags.ModelVersion, "x", "", "Version of model. Default: Latest Version.")
 	flag.IntVar(&flags.BatchSize, "b", 1, "Batch size. Default: 1.")
-	flag.StringVar(&flags.URL, "u", "localhost:8001", "Inference Server URL. Default: localhost:8001")
+	flag.StringVar(&flags.URL, "u", "localhost:8001", "Inference Server URL, a comma-separated list to fail over between, or unix:///path/to/sock. Default: localhost:8001")
+	flag.BoolVar(&flags.TLS, "tls", false, "Connect over TLS. Default: false.")
+	flag.StringVar(&flags.TLSMinVersion, "tls-min-version", "1.2", "Minimum accepted TLS version (1.2 or 1.3). Default: 1.2")
+	flag.StringVar(&flags.TLSCert, "tls-cert", "", "Client certificate PEM file for mutual TLS (with -tls). Default: none.")
+	flag.StringVar(&flags.TLSKey, "tls-key", "", "Client key PEM file for mutual TLS (with -tls). Default: none.")
+	flag.StringVar(&flags.TLSCA, "tls-ca", "", "CA PEM file trusted to sign the server certificate (with -tls). Default: system roots.")
+	flag.StringVar(&flags.TLSServerName, "tls-server-name", "", "Name to verify the server certificate against (with -tls). Default: the dialed host.")
+	flag.StringVar(&flags.Region, "region", "", "Client region attached as request metadata. Default: none.")
+	flag.DurationVar(&flags.PollInterval, "poll-interval", 500*time.Millisecond, "Initial interval between readiness checks, doubling up to 5s. Must be positive. Default: 500ms.")
+	flag.DurationVar(&flags.MaxWait, "max-wait", 0, "Maximum time to wait for the server to become ready. Default: don't wait.")
+	flag.StringVar(&flags.OutputCSV, "output-csv", "", "Also write decoded outputs to this CSV file. Default: none.")
+	flag.IntVar(&flags.MaxConcurrent, "max-concurrent", 0, "Maximum number of inferences in flight. Default: unlimited.")
+	flag.Var(&flags.ModelCompression, "model-compression", "Compress requests for a model, as name=compressor (e.g. simple=gzip). May be repeated.")
+	flag.StringVar(&flags.AuditLog, "audit-log", "", "Append a JSON audit record per inference to this file. Default: none.")
+	flag.BoolVar(&flags.Quiet, "quiet", false, "Only print the decoded outputs. Errors still go to stderr. Default: false.")
+	flag.BoolVar(&flags.Timing, "timing", false, "Report encode, RPC and decode time to stderr. Default: false.")
+	flag.BoolVar(&flags.TraceIDs, "trace-ids", false, "Attach and log a trace id on every request. Default: false.")
+	flag.StringVar(&flags.Labels, "labels", "", "Labels file used to classify OUTPUT0, one label per line. OUTPUT0 must be FP32. Default: none.")
+	flag.IntVar(&flags.TopK, "top-k", 3, "Number of classes reported per batch element with -labels. Default: 3.")
+	flag.DurationVar(&flags.Timeout, "timeout", tritonclient.DefaultTimeout, "Deadline for each request. Default: 10s.")
+	flag.StringVar(&flags.RequestID, "request-id", "", "Id sent with the inference request and checked against the response. Default: none.")
+	flag.DurationVar(&flags.Benchmark, "benchmark", 0, "Send inferences for this long and report throughput and latency instead of a single inference. Default: off.")
+	flag.IntVar(&flags.Concurrency, "concurrency", 1, "Number of inferences in flight at once with -benchmark. Default: 1.")
+	flag.BoolVar(&flags.BenchmarkJSON, "benchmark-json", false, "Print the -benchmark report as JSON. Default: false.")
+	flag.StringVar(&flags.Output, "o", "text", "Output format, text or json. Default: text.")
+	flag.StringVar(&flags.Output, "output", "text", "Same as -o.")
 	flag.Parse()
 	return flags
 }
 
-func ServerLiveRequest(client triton.GRPCInferenceServiceClient) *triton.ServerLiveResponse {
-	// Create context for our request with 10 second timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	serverLiveRequest := triton.ServerLiveRequest{}
-	// Submit ServerLive request to server
-	serverLiveResponse, err := client.ServerLive(ctx, &serverLiveRequest)
-	if err != nil {
-		log.Fatalf("Couldn't get server live: %v", err)
+func parseTLSVersion(version string) (uint16, error) {
+	switch version {
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
 	}
-	return serverLiveResponse
+	return 0, fmt.Errorf("unsupported TLS version %q", version)
 }
 
-func ServerReadyRequest(client triton.GRPCInferenceServiceClient) *triton.ServerReadyResponse {
-	// Create context for our request with 10 second timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+func ServerLiveRequest(ctx context.Context, client *tritonclient.TritonClient) (bool, error) {
+	// Submit ServerLive request to server
+	return client.Live(ctx)
+}
 
-	serverReadyRequest := triton.ServerReadyRequest{}
+func ServerReadyRequest(ctx context.Context, client *tritonclient.TritonClient) (bool, error) {
 	// Submit ServerReady request to server
-	serverReadyResponse, err := client.ServerReady(ctx, &serverReadyRequest)
-	if err != nil {
-		log.Fatalf("Couldn't get server ready: %v", err)
-	}
-	return serverReadyResponse
+	return client.Ready(ctx)
 }
 
-func ModelMetadataRequest(client triton.GRPCInferenceServiceClient, modelName string, modelVersion string) *triton.ModelMetadataResponse {
-	// Create context for our request with 10 second timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+func ModelReadyRequest(ctx context.Context, client *tritonclient.TritonClient, modelName string, modelVersion string) (bool, error) {
+	// Submit ModelReady request to server
+	return client.ModelReady(ctx, modelName, modelVersion)
+}
 
-	// Create status request for a given model
-	modelMetadataRequest := triton.ModelMetadataRequest{
-		Name:    modelName,
-		Version: modelVersion,
-	}
+func ModelMetadataRequest(ctx context.Context, client *tritonclient.TritonClient, modelName string, modelVersion string) (*triton.ModelMetadataResponse, error) {
 	// Submit modelMetadata request to server
-	modelMetadataResponse, err := client.ModelMetadata(ctx, &modelMetadataRequest)
-	if err != nil {
-		log.Fatalf("Couldn't get server model metadata: %v", err)
-	}
-	return modelMetadataResponse
+	return client.ModelMetadata(ctx, modelName, modelVersion)
 }
 
-func ModelInferRequest(client triton.GRPCInferenceServiceClient, batchSize int, inputStrBytes []byte, modelName string, modelVersion string) *triton.ModelInferResponse {
-	// Create context for our request with 10 second timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	inputShape := make([]int64, 2)
-	inputShape[0] = int64(batchSize)
-	inputShape[1] = 1
-	// Create request input tensors
-	inferInputs := []*triton.ModelInferRequest_InferInputTensor{
-		&triton.ModelInferRequest_InferInputTensor{
-			Name:     "INPUT0",
-			Datatype: "BYTES",
-			Shape:    inputShape,
-		},
-	}
-
-	// Create request input output tensors
-	inferOutputs := []*triton.ModelInferRequest_InferRequestedOutputTensor{
-		&triton.ModelInferRequest_InferRequestedOutputTensor{
-			Name: "OUTPUT0",
-		},
-		&triton.ModelInferRequest_InferRequestedOutputTensor{
-			Name: "OUTPUT1",
-		},
-	}
-
+func ModelInferRequest(ctx context.Context, client *tritonclient.TritonClient, inputShape []int64, inputStrBytes []byte, modelName string, modelVersion string, requestID string) (*triton.ModelInferResponse, time.Duration, error) {
 	// Create inference request for specific model/version
-	modelInferRequest := triton.ModelInferRequest{
-		ModelName:    modelName,
-		ModelVersion: modelVersion,
-		Inputs:       inferInputs,
-		Outputs:      inferOutputs,
+	modelInferRequest, err := tritonclient.NewRequestBuilder(modelName, modelVersion).
+		WithId(requestID).
+		WithInput("INPUT0", "BYTES", inputShape, inputStrBytes).
+		WithOutput("OUTPUT0").
+		WithOutput("OUTPUT1").
+		Build()
+	if err != nil {
+		return nil, 0, fmt.Errorf("couldn't build InferRequest: %w", err)
 	}
 
-	modelInferRequest.RawInputContents = append(modelInferRequest.RawInputContents, inputStrBytes)
-
 	// Submit inference request to server
-	modelInferResponse, err := client.ModelInfer(ctx, &modelInferRequest)
+	modelInferResponse, latency, err := client.InferWithLatency(ctx, modelInferRequest)
 	if err != nil {
-		log.Fatalf("Error processing InferRequest: %v", err)
+		return nil, latency, err
+	}
+	if err := tritonclient.CheckResponseID(modelInferRequest, modelInferResponse); err != nil {
+		return nil, latency, err
 	}
-	return modelInferResponse
+	return modelInferResponse, latency, nil
 }
 
-// Convert string input data into raw bytes (assumes Little Endian)
-func Preprocess(inputStrList[]string, batchSize int) []byte {
-
-	var inputStrBytes []byte
-	// Temp variable to hold our converted int64 -> []byte
-	bs := make([]byte, 4)
-	for b := 0; b < batchSize; b++ {
-		inputStr := inputStrList[b]
-		strBytes := []byte(inputStr)
-		strCap := len(inputStr)
-		binary.LittleEndian.PutUint32(bs, uint32(strCap))
-		inputStrBytes = append(inputStrBytes, bs...)
-		for i := 0; i < strCap; i++ {
-			inputStrBytes = append(inputStrBytes, strBytes[i])
-		}
+// runBenchmark sends the inference request from -concurrency workers for
+// -benchmark over the client's single connection and prints the report.
+func runBenchmark(ctx context.Context, client *tritonclient.TritonClient, flags Flags, inputShape []int64, inputStrBytes []byte) error {
+	req, err := tritonclient.NewRequestBuilder(flags.ModelName, flags.ModelVersion).
+		WithInput("INPUT0", "BYTES", inputShape, inputStrBytes).
+		WithOutput("OUTPUT0").
+		WithOutput("OUTPUT1").
+		Build()
+	if err != nil {
+		return fmt.Errorf("couldn't build InferRequest: %w", err)
 	}
-	return inputStrBytes
+	result := client.Benchmark(ctx, req, flags.Concurrency, flags.Benchmark)
+	if flags.BenchmarkJSON {
+		return json.NewEncoder(os.Stdout).Encode(result)
+	}
+	fmt.Println(result)
+	return nil
 }
 
-// Convert slice of 4 bytes to int32 (assumes Little Endian)
-func readInt32(fourBytes []byte) int32 {
+// Convert slice of 4 bytes to int32 stored in the given byte order
+func readInt32(fourBytes []byte, order binary.ByteOrder) int32 {
 	buf := bytes.NewBuffer(fourBytes)
 	var retval int32
-	binary.Read(buf, binary.LittleEndian, &retval)
+	binary.Read(buf, order, &retval)
 	return retval
 }
 
-// Convert output's raw bytes into float32 data (assumes Little Endian)
-func Postprocess(inferResponse *triton.ModelInferResponse, batchSize int) [][]int32 {
-	outputBytes0 := inferResponse.RawOutputContents[0]
-	outputBytes1 := inferResponse.RawOutputContents[1]
-
+// Convert the INT32 outputs' raw bytes into int32 data, one slice per
+// output (assumes Little Endian)
+func PostprocessInt32(inferResponse *triton.ModelInferResponse, batchSize int) ([][]int32, error) {
     max_size := batchSize * outputSize
+	if len(inferResponse.Outputs) < 2 || len(inferResponse.RawOutputContents) < 2 {
+		return nil, fmt.Errorf("response has %d outputs and %d raw output contents, expected 2 of each",
+			len(inferResponse.Outputs), len(inferResponse.RawOutputContents))
+	}
+	for i := range inferResponse.Outputs {
+		if err := tritonclient.CheckOutputDatatype(inferResponse, i, "INT32"); err != nil {
+			return nil, err
+		}
+		if err := tritonclient.CheckRawOutputShape(inferResponse, i); err != nil {
+			return nil, err
+		}
+	}
+
+	// Look outputs up by name, the server may return them in any order
+	rawOutputs := tritonclient.RawOutputsByName(inferResponse)
+	for _, name := range []string{"OUTPUT0", "OUTPUT1"} {
+		if len(rawOutputs[name])%4 != 0 {
+			return nil, fmt.Errorf("output %s has %d bytes, not a whole number of INT32 elements", name, len(rawOutputs[name]))
+		}
+		if n := len(rawOutputs[name]) / 4; n < max_size {
+			return nil, fmt.Errorf("output %s has %d elements, expected %d", name, n, max_size)
+		}
+	}
+	outputBytes0 := rawOutputs["OUTPUT0"]
+	outputBytes1 := rawOutputs["OUTPUT1"]
+
 	outputData0 := make([]int32, max_size)
 	outputData1 := make([]int32, max_size)
+	// Triton always returns little-endian contents
 	for i := 0; i < max_size; i++ {
-		outputData0[i] = readInt32(outputBytes0[i*4 : i*4+4])
-		outputData1[i] = readInt32(outputBytes1[i*4 : i*4+4])
+		outputData0[i] = readInt32(outputBytes0[i*4:i*4+4], binary.LittleEndian)
+		outputData1[i] = readInt32(outputBytes1[i*4:i*4+4], binary.LittleEndian)
 	}
-	return [][]int32{outputData0, outputData1}
+	return [][]int32{outputData0, outputData1}, nil
 }
 
 func main() {
 	FLAGS := parseFlags()
-	fmt.Println("FLAGS:", FLAGS)
+
+	if FLAGS.Output != "text" && FLAGS.Output != "json" {
+		log.Fatalf("Invalid -o %q, expected text or json", FLAGS.Output)
+	}
+	if FLAGS.Output == "json" && FLAGS.Labels != "" {
+		log.Fatalf("-labels is only supported with -o text")
+	}
+
+	// Non-essential output is discarded in quiet mode, and in JSON mode
+	// so that stdout holds nothing but the result
+	var info io.Writer = os.Stdout
+	if FLAGS.Quiet || FLAGS.Output == "json" {
+		info = io.Discard
+	}
+	fmt.Fprintln(info, "FLAGS:", FLAGS)
+
+	opts := []tritonclient.Option{
+		tritonclient.WithDefaultTimeout(FLAGS.Timeout),
+		tritonclient.WithLogger(tritonclient.StdLogger(log.Default())),
+	}
+	if FLAGS.TLS {
+		minVersion, err := parseTLSVersion(FLAGS.TLSMinVersion)
+		if err != nil {
+			log.Fatalf("Invalid -tls-min-version: %v", err)
+		}
+		opts = append(opts, tritonclient.WithTLSConfig(&tls.Config{}), tritonclient.WithMinTLSVersion(minVersion))
+		if FLAGS.TLSCert != "" || FLAGS.TLSKey != "" || FLAGS.TLSCA != "" {
+			opts = append(opts, tritonclient.WithTLSFiles(FLAGS.TLSCert, FLAGS.TLSKey, FLAGS.TLSCA))
+		}
+		if FLAGS.TLSServerName != "" {
+			opts = append(opts, tritonclient.WithTLSServerName(FLAGS.TLSServerName))
+		}
+	}
+	if FLAGS.MaxConcurrent > 0 {
+		opts = append(opts, tritonclient.WithMaxConcurrent(FLAGS.MaxConcurrent))
+	}
+	for _, setting := range FLAGS.ModelCompression {
+		model, compressor, ok := strings.Cut(setting, "=")
+		if !ok {
+			log.Fatalf("Invalid -model-compression %q, expected name=compressor", setting)
+		}
+		opts = append(opts, tritonclient.WithModelCompression(model, compressor))
+	}
+	if FLAGS.AuditLog != "" {
+		auditLog, err := os.OpenFile(FLAGS.AuditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			log.Fatalf("Couldn't open audit log: %v", err)
+		}
+		defer auditLog.Close()
+		opts = append(opts, tritonclient.WithAuditLog(auditLog))
+	}
+	if FLAGS.TraceIDs {
+		opts = append(opts, tritonclient.WithTraceIDs())
+	}
+	if FLAGS.Region != "" {
+		opts = append(opts, tritonclient.WithRegion(FLAGS.Region))
+	}
 
 	// Connect to gRPC server
-	conn, err := grpc.Dial(FLAGS.URL, grpc.WithInsecure())
+	tritonClient, err := tritonclient.NewTritonClient(FLAGS.URL, opts...)
 	if err != nil {
-		log.Fatalf("Couldn't connect to endpoint %s: %v", FLAGS.URL, err)
+		log.Fatalf("%v", err)
 	}
-	defer conn.Close()
+	defer tritonClient.Close()
 
-	// Create client from gRPC server connection
-	client := triton.NewGRPCInferenceServiceClient(conn)
+	// Each request gets the -timeout deadline from the client
+	ctx := context.Background()
 
-	serverLiveResponse := ServerLiveRequest(client)
-	fmt.Printf("Triton Health - Live: %v\n", serverLiveResponse.Live)
+	if FLAGS.MaxWait > 0 {
+		if err := tritonClient.WaitUntilReady(ctx, FLAGS.PollInterval, FLAGS.MaxWait); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
 
-	serverReadyResponse := ServerReadyRequest(client)
-	fmt.Printf("Triton Health - Ready: %v\n", serverReadyResponse.Ready)
+	live, err := ServerLiveRequest(ctx, tritonClient)
+	if err != nil {
+		log.Fatalf("Couldn't get server live: %v", err)
+	}
+	fmt.Fprintf(info, "Triton Health - Live: %v\n", live)
+	if state, ok := tritonClient.TLSConnectionState(); ok {
+		fmt.Fprintf(info, "TLS: %s %s\n", tls.VersionName(state.Version), tls.CipherSuiteName(state.CipherSuite))
+	}
 
-	modelMetadataResponse := ModelMetadataRequest(client, FLAGS.ModelName, "")
-	fmt.Println(modelMetadataResponse)
+	ready, err := ServerReadyRequest(ctx, tritonClient)
+	if err != nil {
+		log.Fatalf("Couldn't get server ready: %v", err)
+	}
+	fmt.Fprintf(info, "Triton Health - Ready: %v\n", ready)
 
-    inputStr := []string{"test", "test"}
-    batchSize := len(inputStr)
-	inputStrBytes := Preprocess(inputStr, batchSize)
+	modelReady, err := ModelReadyRequest(ctx, tritonClient, FLAGS.ModelName, FLAGS.ModelVersion)
+	if err != nil {
+		log.Fatalf("Couldn't get model ready: %v", err)
+	}
+	fmt.Fprintf(info, "Triton Health - Model Ready: %v\n", modelReady)
+
+	modelMetadataResponse, err := ModelMetadataRequest(ctx, tritonClient, FLAGS.ModelName, "")
+	if err != nil {
+		log.Fatalf("Couldn't get server model metadata: %v", err)
+	}
+	fmt.Fprintln(info, modelMetadataResponse)
+	if FLAGS.Labels != "" {
+		if err := checkClassifiable(modelMetadataResponse, "OUTPUT0"); err != nil {
+			log.Fatalf("Can't use -labels: %v", err)
+		}
+	}
+
+	modelState, err := tritonClient.ModelState(ctx, FLAGS.ModelName, FLAGS.ModelVersion)
+	if err != nil {
+		log.Fatalf("Couldn't get model state: %v", err)
+	}
+	fmt.Fprintf(info, "Model State: %v\n", modelState)
+
+	optimization, err := tritonClient.ModelOptimization(ctx, FLAGS.ModelName, "")
+	if err != nil {
+		log.Fatalf("Couldn't get model optimization: %v", err)
+	}
+	fmt.Fprintf(info, "Model Optimization: %+v\n", optimization)
+
+	inputStr := [][]string{{"test"}, {"test"}}
+	encodeStart := time.Now()
+	inputStrBytes, inputShape, err := tritonclient.PreprocessBatch(inputStr)
+	if err != nil {
+		log.Fatalf("Couldn't preprocess inputs: %v", err)
+	}
+	encodeTime := time.Since(encodeStart)
+	batchSize := int(inputShape[0])
+
+	if FLAGS.Benchmark > 0 {
+		if err := runBenchmark(ctx, tritonClient, FLAGS, inputShape, inputStrBytes); err != nil {
+			log.Fatalf("Benchmark failed: %v", err)
+		}
+		return
+	}
 
 	/* We use a simple model that takes 2 input tensors of 16 integers
 	each and returns 2 output tensors of 16 integers each. One
 	output tensor is the element-wise sum of the inputs and one
 	output is the element-wise difference. */
-	inferResponse := ModelInferRequest(client, batchSize, inputStrBytes, FLAGS.ModelName, FLAGS.ModelVersion)
+	inferResponse, latency, err := ModelInferRequest(ctx, tritonClient, inputShape, inputStrBytes, FLAGS.ModelName, FLAGS.ModelVersion, FLAGS.RequestID)
+	if err != nil {
+		log.Fatalf("Error processing InferRequest: %v", err)
+	}
+	fmt.Fprintf(info, "Inference latency: %v\n", latency)
+	fmt.Fprintf(info, "Served by model %s version %s\n", inferResponse.ModelName, inferResponse.ModelVersion)
 
 	/* We expect there to be 2 results (each with batch-size 1). Walk
 	over all 16 result elements and print the sum and difference
 	calculated by the model. */
-	outputs := Postprocess(inferResponse, batchSize)
+	decodeStart := time.Now()
+	if err := tritonclient.ValidateOutputs(inferResponse, modelMetadataResponse); err != nil {
+		log.Fatalf("Response doesn't match the model metadata: %v", err)
+	}
+	outputs, err := PostprocessInt32(inferResponse, batchSize)
+	if err != nil {
+		log.Fatalf("Couldn't postprocess outputs: %v", err)
+	}
+	decodeTime := time.Since(decodeStart)
+	if FLAGS.Timing {
+		fmt.Fprintf(os.Stderr, "Timing - encode: %v, rpc: %v, decode: %v\n", encodeTime, latency, decodeTime)
+	}
 	outputData0 := outputs[0]
 	outputData1 := outputs[1]
 
-	fmt.Println("\nChecking Inference Outputs\n--------------------------")
-	fmt.Println(outputData0, outputData1)
+	if FLAGS.Output == "json" {
+		result := jsonResult{
+			Model:     inferResponse.ModelName,
+			Version:   inferResponse.ModelVersion,
+			ID:        inferResponse.Id,
+			LatencyNs: latency.Nanoseconds(),
+			Outputs:   map[string][]int32{"OUTPUT0": outputData0, "OUTPUT1": outputData1},
+		}
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			log.Fatalf("Couldn't write JSON result: %v", err)
+		}
+	} else {
+		fmt.Fprintln(info, "\nChecking Inference Outputs\n--------------------------")
+		fmt.Println(outputData0, outputData1)
+	}
+
+	if FLAGS.Labels != "" {
+		if err := printClassifications(os.Stdout, FLAGS.Labels, inferResponse, "OUTPUT0", batchSize, FLAGS.TopK); err != nil {
+			log.Fatalf("Couldn't classify outputs: %v", err)
+		}
+	}
+
+	if FLAGS.OutputCSV != "" {
+		if err := writeOutputCSV(FLAGS.OutputCSV, []interface{}{outputData0, outputData1}, batchSize); err != nil {
+			log.Fatalf("Couldn't write %s: %v", FLAGS.OutputCSV, err)
+		}
+	}
+}
+
+// jsonResult is the result printed with -o json. Field names are part of
+// the output format scripts rely on, so only add to them.
+type jsonResult struct {
+	Model     string             `json:"model"`
+	Version   string             `json:"version"`
+	ID        string             `json:"id,omitempty"`
+	LatencyNs int64              `json:"latency_ns"`
+	Outputs   map[string][]int32 `json:"outputs"`
+}
+
+func writeOutputCSV(path string, outputs []interface{}, batchSize int) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := tritonclient.WriteCSV(f, []string{"OUTPUT0", "OUTPUT1"}, outputs, batchSize); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// checkClassifiable returns an error unless the model declares output name
+// as FP32, the only datatype whose values are treated as class scores.
+func checkClassifiable(metadata *triton.ModelMetadataResponse, name string) error {
+	for _, output := range metadata.Outputs {
+		if output.Name == name {
+			if output.Datatype != "FP32" {
+				return fmt.Errorf("output %s of model %s is %s, classification needs FP32 scores", name, metadata.Name, output.Datatype)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("model %s has no output %s", metadata.Name, name)
+}
+
+// printClassifications treats each batch element of the FP32 output name
+// as class scores and prints its top-k (label, score) pairs to w. Outputs
+// of any other datatype are rejected.
+func printClassifications(w io.Writer, labelsPath string, inferResponse *triton.ModelInferResponse, name string, batchSize int, k int) error {
+	scores, err := tritonclient.NewInferResult(inferResponse).Float32(name)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(labelsPath)
+	if err != nil {
+		return err
+	}
+	labels, err := tritonclient.ReadLabels(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	width := len(scores) / batchSize
+	for b := 0; b < batchSize; b++ {
+		results, err := tritonclient.Classify(scores[b*width:(b+1)*width], labels, k)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			fmt.Fprintf(w, "%d: %s (%v)\n", b, result.Label, result.Score)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,152 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestReadInt32(t *testing.T) {
+	for _, want := range []int32{0, 1, -1, 42, -42, math.MaxInt32, math.MinInt32} {
+		for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+			b := make([]byte, 4)
+			order.PutUint32(b, uint32(want))
+			if got := readInt32(b, order); got != want {
+				t.Errorf("readInt32(%v, %v) = %d, want %d", b, order, got, want)
+			}
+		}
+	}
+}
+
+// int32Response returns an INT32 response with one output per slice in
+// values, named OUTPUT0, OUTPUT1, ... and shaped [1, len].
+func int32Response(values ...[]int32) *triton.ModelInferResponse {
+	resp := &triton.ModelInferResponse{}
+	for i, v := range values {
+		raw := make([]byte, 4*len(v))
+		for j, x := range v {
+			binary.LittleEndian.PutUint32(raw[j*4:], uint32(x))
+		}
+		resp.Outputs = append(resp.Outputs, &triton.ModelInferResponse_InferOutputTensor{
+			Name:     "OUTPUT" + strconv.Itoa(i),
+			Datatype: "INT32",
+			Shape:    []int64{1, int64(len(v))},
+		})
+		resp.RawOutputContents = append(resp.RawOutputContents, raw)
+	}
+	return resp
+}
+
+func TestPostprocessInt32(t *testing.T) {
+	sums := make([]int32, outputSize)
+	diffs := make([]int32, outputSize)
+	for i := range sums {
+		sums[i] = int32(i) * 2
+		diffs[i] = -int32(i)
+	}
+	sums[0], diffs[0] = math.MaxInt32, math.MinInt32
+
+	got, err := PostprocessInt32(int32Response(sums, diffs), 1)
+	if err != nil {
+		t.Fatalf("PostprocessInt32: %v", err)
+	}
+	if want := [][]int32{sums, diffs}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PostprocessInt32 = %v, want %v", got, want)
+	}
+}
+
+func TestPostprocessInt32Truncated(t *testing.T) {
+	full := make([]int32, outputSize)
+	short := make([]int32, outputSize/2)
+
+	// Contents consistent with their shape but holding fewer elements than
+	// the batch needs are rejected rather than read past the end.
+	if _, err := PostprocessInt32(int32Response(full, short), 1); err == nil {
+		t.Error("PostprocessInt32 accepted a truncated OUTPUT1")
+	}
+	if _, err := PostprocessInt32(int32Response(full, full), 2); err == nil {
+		t.Error("PostprocessInt32 accepted outputs too short for the batch")
+	}
+
+	// A trailing partial element fails too.
+	resp := int32Response(full, full)
+	resp.RawOutputContents[0] = resp.RawOutputContents[0][:len(resp.RawOutputContents[0])-1]
+	if _, err := PostprocessInt32(resp, 1); err == nil {
+		t.Error("PostprocessInt32 accepted a partial INT32 element")
+	}
+}
+
+func TestPrintClassifications(t *testing.T) {
+	labelsPath := filepath.Join(t.TempDir(), "labels.txt")
+	if err := os.WriteFile(labelsPath, []byte("cat\ndog\nbird\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	scores := []float32{0.1, 0.7, 0.2, 0.5, 0.2, 0.3}
+	raw := make([]byte, 4*len(scores))
+	for i, v := range scores {
+		binary.LittleEndian.PutUint32(raw[i*4:], math.Float32bits(v))
+	}
+	resp := &triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT0", Datatype: "FP32", Shape: []int64{2, 3}},
+		},
+		RawOutputContents: [][]byte{raw},
+	}
+
+	var buf bytes.Buffer
+	if err := printClassifications(&buf, labelsPath, resp, "OUTPUT0", 2, 1); err != nil {
+		t.Fatalf("printClassifications: %v", err)
+	}
+	if got, want := buf.String(), "0: dog (0.7)\n1: cat (0.5)\n"; got != want {
+		t.Errorf("printClassifications printed %q, want %q", got, want)
+	}
+
+	// The string model's INT32 sums aren't scores.
+	if err := printClassifications(&buf, labelsPath, int32Response(make([]int32, outputSize)), "OUTPUT0", 1, 1); err == nil {
+		t.Error("printClassifications accepted an INT32 output")
+	}
+	metadata := &triton.ModelMetadataResponse{
+		Name:    "simple_string",
+		Outputs: []*triton.ModelMetadataResponse_TensorMetadata{{Name: "OUTPUT0", Datatype: "INT32"}},
+	}
+	if err := checkClassifiable(metadata, "OUTPUT0"); err == nil {
+		t.Error("checkClassifiable accepted an INT32 output")
+	}
+	metadata.Outputs[0].Datatype = "FP32"
+	if err := checkClassifiable(metadata, "OUTPUT0"); err != nil {
+		t.Errorf("checkClassifiable of an FP32 output: %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+)
+
+// AuditRecord describes one ModelInfer call in the audit log.
+type AuditRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	Model     string    `json:"model"`
+	Version   string    `json:"version"`
+	BatchSize int64     `json:"batch_size"`
+	RequestID string    `json:"request_id,omitempty"`
+	LatencyMs float64   `json:"latency_ms"`
+	Success   bool      `json:"success"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// WithAuditLog writes an AuditRecord as a JSON line to w for every
+// ModelInfer call made through the client. Writes are serialized.
+func WithAuditLog(w io.Writer) Option {
+	return func(o *options) {
+		o.unaryInterceptors = append(o.unaryInterceptors, auditInterceptor(w))
+	}
+}
+
+func auditInterceptor(w io.Writer) grpc.UnaryClientInterceptor {
+	var mu sync.Mutex
+	enc := json.NewEncoder(w)
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		inferReq, ok := req.(*triton.ModelInferRequest)
+		if !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		record := AuditRecord{
+			Timestamp: start.UTC(),
+			Model:     inferReq.ModelName,
+			Version:   inferReq.ModelVersion,
+			RequestID: inferReq.Id,
+			LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			Success:   err == nil,
+		}
+		if len(inferReq.Inputs) > 0 && len(inferReq.Inputs[0].Shape) > 0 {
+			record.BatchSize = inferReq.Inputs[0].Shape[0]
+		}
+		if err != nil {
+			record.Error = err.Error()
+		} else if resp, ok := reply.(*triton.ModelInferResponse); ok && resp.ModelVersion != "" {
+			record.Version = resp.ModelVersion
+		}
+
+		mu.Lock()
+		enc.Encode(record)
+		mu.Unlock()
+		return err
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuditLog(t *testing.T) {
+	var auditLog bytes.Buffer
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(_ context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			if req.ModelName == "missing" {
+				return nil, status.Error(codes.NotFound, "unknown model")
+			}
+			return &triton.ModelInferResponse{ModelName: req.ModelName, ModelVersion: "3", Id: req.Id}, nil
+		},
+	}, WithAuditLog(&auditLog))
+
+	for _, model := range []string{"simple", "missing"} {
+		req, err := NewRequestBuilder(model, "").
+			WithInput("INPUT0", "INT32", []int64{2, 1}, []int32{1, 2}).
+			WithId("req-" + model).
+			Build()
+		if err != nil {
+			t.Fatalf("Build: %v", err)
+		}
+		client.InferWithLatency(context.Background(), req)
+	}
+
+	dec := json.NewDecoder(&auditLog)
+	var ok, failed AuditRecord
+	if err := dec.Decode(&ok); err != nil {
+		t.Fatalf("decoding first record: %v", err)
+	}
+	if err := dec.Decode(&failed); err != nil {
+		t.Fatalf("decoding second record: %v", err)
+	}
+
+	if !ok.Success || ok.Model != "simple" || ok.Version != "3" || ok.BatchSize != 2 || ok.RequestID != "req-simple" {
+		t.Errorf("success record = %+v", ok)
+	}
+	if failed.Success || failed.Error == "" || failed.Model != "missing" {
+		t.Errorf("failure record = %+v", failed)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+// BenchmarkResult summarizes a Benchmark run. Latency percentiles cover
+// successful requests only.
+type BenchmarkResult struct {
+	Requests    int           `json:"requests"`
+	Errors      int           `json:"errors"`
+	Concurrency int           `json:"concurrency"`
+	Duration    time.Duration `json:"duration_ns"`
+	QPS         float64       `json:"qps"`
+	ErrorRate   float64       `json:"error_rate"`
+	P50         time.Duration `json:"p50_ns"`
+	P90         time.Duration `json:"p90_ns"`
+	P99         time.Duration `json:"p99_ns"`
+}
+
+func (r BenchmarkResult) String() string {
+	return fmt.Sprintf("%d requests in %v at concurrency %d: %.1f infer/sec, %.2f%% errors, latency p50 %v p90 %v p99 %v",
+		r.Requests, r.Duration, r.Concurrency, r.QPS, 100*r.ErrorRate, r.P50, r.P90, r.P99)
+}
+
+// Benchmark sends req repeatedly over the client's connection from
+// concurrency workers for duration, or until ctx is done, and reports
+// throughput and latency. Requests in flight when duration elapses are
+// allowed to finish and are counted.
+func (c *TritonClient) Benchmark(ctx context.Context, req *triton.ModelInferRequest, concurrency int, duration time.Duration) BenchmarkResult {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	var (
+		mu        sync.Mutex
+		latencies []time.Duration
+		errors    int
+		wg        sync.WaitGroup
+	)
+	start := time.Now()
+	end := start.Add(duration)
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil && time.Now().Before(end) {
+				callStart := time.Now()
+				_, err := c.Infer(ctx, req)
+				latency := time.Since(callStart)
+				mu.Lock()
+				if err != nil {
+					errors++
+				} else {
+					latencies = append(latencies, latency)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	result := BenchmarkResult{
+		Requests:    len(latencies) + errors,
+		Errors:      errors,
+		Concurrency: concurrency,
+		Duration:    time.Since(start),
+	}
+	if result.Requests > 0 {
+		result.QPS = float64(result.Requests) / result.Duration.Seconds()
+		result.ErrorRate = float64(errors) / float64(result.Requests)
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	result.P50 = percentile(latencies, 0.50)
+	result.P90 = percentile(latencies, 0.90)
+	result.P99 = percentile(latencies, 0.99)
+	return result
+}
+
+// percentile returns the nearest-rank p quantile of sorted.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
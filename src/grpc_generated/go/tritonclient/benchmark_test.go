@@ -0,0 +1,79 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestBenchmark(t *testing.T) {
+	var calls int32
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			if atomic.AddInt32(&calls, 1)%4 == 0 {
+				return nil, fmt.Errorf("rejected")
+			}
+			time.Sleep(time.Millisecond)
+			return &triton.ModelInferResponse{}, nil
+		},
+	})
+
+	result := client.Benchmark(context.Background(), &triton.ModelInferRequest{ModelName: "simple"}, 3, 50*time.Millisecond)
+	if result.Requests != int(atomic.LoadInt32(&calls)) {
+		t.Errorf("Requests = %d, server saw %d", result.Requests, calls)
+	}
+	if result.Requests == 0 || result.Errors != result.Requests/4 {
+		t.Errorf("%d errors in %d requests, want every fourth to fail", result.Errors, result.Requests)
+	}
+	if result.Concurrency != 3 || result.QPS <= 0 || result.Duration < 50*time.Millisecond {
+		t.Errorf("result = %+v", result)
+	}
+	if result.P50 < time.Millisecond || result.P50 > result.P90 || result.P90 > result.P99 {
+		t.Errorf("latencies p50 %v p90 %v p99 %v", result.P50, result.P90, result.P99)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i))
+	}
+	for p, want := range map[float64]time.Duration{0: 1, 0.5: 50, 0.9: 90, 0.99: 99, 1: 100} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("percentile(%v) = %d, want %d", p, got, want)
+		}
+	}
+	if got := percentile(nil, 0.5); got != 0 {
+		t.Errorf("percentile of no latencies = %v", got)
+	}
+}
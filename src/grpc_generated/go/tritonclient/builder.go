@@ -0,0 +1,253 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+// RequestBuilder assembles a ModelInferRequest. Each method records the
+// first error it encounters and Build returns it, so calls can be chained.
+type RequestBuilder struct {
+	req       *triton.ModelInferRequest
+	batchSize int64
+	err       error
+}
+
+// NewRequestBuilder starts a request for the given model. An empty
+// modelVersion selects the latest version.
+func NewRequestBuilder(modelName, modelVersion string) *RequestBuilder {
+	return &RequestBuilder{
+		req: &triton.ModelInferRequest{
+			ModelName:    modelName,
+			ModelVersion: modelVersion,
+		},
+	}
+}
+
+// WithInput adds an input tensor and encodes data as its raw contents
+// with EncodeTensor.
+func (b *RequestBuilder) WithInput(name, datatype string, shape []int64, data interface{}) *RequestBuilder {
+	if b.err != nil {
+		return b
+	}
+	raw, err := EncodeTensor(datatype, data)
+	if err != nil {
+		b.err = fmt.Errorf("input %s: %w", name, err)
+		return b
+	}
+	b.req.Inputs = append(b.req.Inputs, &triton.ModelInferRequest_InferInputTensor{
+		Name:     name,
+		Datatype: datatype,
+		Shape:    shape,
+	})
+	b.req.RawInputContents = append(b.req.RawInputContents, raw)
+	return b
+}
+
+// RawInput pairs an input tensor with its encoded contents, as returned by
+// NewInput.
+type RawInput struct {
+	Tensor   *triton.ModelInferRequest_InferInputTensor
+	Contents []byte
+}
+
+// WithRawInputs adds already encoded inputs in order. Each tensor and its
+// contents are appended together, so RawInputContents stays aligned with
+// Inputs; the server matches them by position, and a misaligned request
+// silently computes on the wrong data.
+func (b *RequestBuilder) WithRawInputs(inputs ...RawInput) *RequestBuilder {
+	if b.err != nil {
+		return b
+	}
+	for i, input := range inputs {
+		if input.Tensor == nil {
+			b.err = fmt.Errorf("raw input %d has no tensor", i)
+			return b
+		}
+		b.req.Inputs = append(b.req.Inputs, input.Tensor)
+		b.req.RawInputContents = append(b.req.RawInputContents, input.Contents)
+	}
+	return b
+}
+
+// WithOutput requests the named output. If no outputs are requested the
+// server returns all of them.
+func (b *RequestBuilder) WithOutput(name string) *RequestBuilder {
+	b.req.Outputs = append(b.req.Outputs, &triton.ModelInferRequest_InferRequestedOutputTensor{
+		Name: name,
+	})
+	return b
+}
+
+// WithClassificationOutput requests the named output as its top k
+// classes rather than raw scores. The server returns a BYTES output of k
+// "score:index:label" strings per batch entry, best first; see
+// DecodeClassifications.
+func (b *RequestBuilder) WithClassificationOutput(name string, k int) *RequestBuilder {
+	if b.err == nil && k <= 0 {
+		b.err = fmt.Errorf("output %s: invalid classification count %d", name, k)
+	}
+	b.req.Outputs = append(b.req.Outputs, &triton.ModelInferRequest_InferRequestedOutputTensor{
+		Name: name,
+		Parameters: map[string]*triton.InferParameter{
+			"classification": {ParameterChoice: &triton.InferParameter_Int64Param{Int64Param: int64(k)}},
+		},
+	})
+	return b
+}
+
+// WithParameter sets a request parameter. value must be a bool, an
+// integer or a string. Triton itself recognizes "priority" and "timeout"
+// (see WithPriority and WithRequestTimeout) and "sequence_id",
+// "sequence_start" and "sequence_end" (see WithSequence); other keys are
+// passed through to the model's backend.
+func (b *RequestBuilder) WithParameter(key string, value interface{}) *RequestBuilder {
+	if b.err != nil {
+		return b
+	}
+	param, err := inferParameter(value)
+	if err != nil {
+		b.err = fmt.Errorf("parameter %s: %w", key, err)
+		return b
+	}
+	if b.req.Parameters == nil {
+		b.req.Parameters = make(map[string]*triton.InferParameter)
+	}
+	b.req.Parameters[key] = param
+	return b
+}
+
+// WithPriority sets the "priority" parameter, which the dynamic and
+// sequence batchers use to schedule the request. 1 is the highest
+// priority; 0 selects the model's default.
+func (b *RequestBuilder) WithPriority(priority int64) *RequestBuilder {
+	if b.err == nil && priority < 0 {
+		b.err = fmt.Errorf("invalid priority %d", priority)
+		return b
+	}
+	return b.WithParameter("priority", priority)
+}
+
+// WithRequestTimeout sets the "timeout" parameter, in microseconds, after
+// which the server drops the request if it hasn't started executing. It
+// is separate from the RPC deadline.
+func (b *RequestBuilder) WithRequestTimeout(timeout time.Duration) *RequestBuilder {
+	if b.err == nil && timeout < time.Microsecond {
+		b.err = fmt.Errorf("invalid request timeout %v", timeout)
+		return b
+	}
+	return b.WithParameter("timeout", timeout.Microseconds())
+}
+
+// WithBatchSize makes Build check that the first dimension of every input
+// equals n.
+func (b *RequestBuilder) WithBatchSize(n int) *RequestBuilder {
+	b.batchSize = int64(n)
+	return b
+}
+
+// WithId sets the request id, which the server echoes in the response.
+func (b *RequestBuilder) WithId(id string) *RequestBuilder {
+	b.req.Id = id
+	return b
+}
+
+// Build validates and returns the request.
+func (b *RequestBuilder) Build() (*triton.ModelInferRequest, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if b.req.ModelName == "" {
+		return nil, errors.New("model name is required")
+	}
+	version, err := normalizeVersion(b.req.ModelVersion)
+	if err != nil {
+		return nil, err
+	}
+	b.req.ModelVersion = version
+	if len(b.req.Inputs) == 0 {
+		return nil, errors.New("request has no inputs")
+	}
+	seen := make(map[string]bool)
+	for _, input := range b.req.Inputs {
+		if seen[input.Name] {
+			return nil, fmt.Errorf("duplicate input tensor name %s", input.Name)
+		}
+		seen[input.Name] = true
+		if err := b.checkShape(input); err != nil {
+			return nil, err
+		}
+	}
+	seen = make(map[string]bool)
+	for _, output := range b.req.Outputs {
+		if seen[output.Name] {
+			return nil, fmt.Errorf("duplicate requested output name %s", output.Name)
+		}
+		seen[output.Name] = true
+	}
+	if err := ValidateRawInputContents(b.req); err != nil {
+		return nil, err
+	}
+	return b.req, nil
+}
+
+// checkShape rejects zero or negative dimensions, other than -1 for a
+// dynamic dimension, and a batch dimension that disagrees with the batch
+// size.
+func (b *RequestBuilder) checkShape(input *triton.ModelInferRequest_InferInputTensor) error {
+	for i, dim := range input.Shape {
+		if dim <= 0 && dim != -1 {
+			return fmt.Errorf("input %s has invalid dimension %d at index %d in shape %v", input.Name, dim, i, input.Shape)
+		}
+	}
+	if b.batchSize > 0 && (len(input.Shape) == 0 || input.Shape[0] != b.batchSize) {
+		return fmt.Errorf("input %s has shape %v, expected batch dimension %d", input.Name, input.Shape, b.batchSize)
+	}
+	return nil
+}
+
+// inferParameter wraps a Go value in the matching InferParameter choice.
+func inferParameter(value interface{}) (*triton.InferParameter, error) {
+	switch v := value.(type) {
+	case bool:
+		return &triton.InferParameter{ParameterChoice: &triton.InferParameter_BoolParam{BoolParam: v}}, nil
+	case int:
+		return &triton.InferParameter{ParameterChoice: &triton.InferParameter_Int64Param{Int64Param: int64(v)}}, nil
+	case int32:
+		return &triton.InferParameter{ParameterChoice: &triton.InferParameter_Int64Param{Int64Param: int64(v)}}, nil
+	case int64:
+		return &triton.InferParameter{ParameterChoice: &triton.InferParameter_Int64Param{Int64Param: v}}, nil
+	case string:
+		return &triton.InferParameter{ParameterChoice: &triton.InferParameter_StringParam{StringParam: v}}, nil
+	}
+	return nil, fmt.Errorf("unsupported parameter type %T", value)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRequestBuilder(t *testing.T) {
+	req, err := NewRequestBuilder("simple", "1").
+		WithInput("INPUT0", "INT32", []int64{1, 2}, []int32{1, -1}).
+		WithInput("INPUT1", "BYTES", []int64{1, 1}, []string{"ab"}).
+		WithOutput("OUTPUT0").
+		WithParameter("priority", 1).
+		WithId("req-1").
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	if len(req.Inputs) != 2 || len(req.RawInputContents) != 2 {
+		t.Fatalf("got %d inputs and %d raw contents, want 2 of each", len(req.Inputs), len(req.RawInputContents))
+	}
+	if got := req.RawInputContents[0]; len(got) != 8 || got[4] != 0xff {
+		t.Errorf("INPUT0 raw contents = %v", got)
+	}
+	if got := string(req.RawInputContents[1]); got != "\x02\x00\x00\x00ab" {
+		t.Errorf("INPUT1 raw contents = %q", got)
+	}
+	if req.Parameters["priority"].GetInt64Param() != 1 {
+		t.Errorf("priority parameter = %v", req.Parameters["priority"])
+	}
+	if req.Id != "req-1" {
+		t.Errorf("Id = %q, want req-1", req.Id)
+	}
+}
+
+func TestRequestBuilderRawInputs(t *testing.T) {
+	var inputs []RawInput
+	for i, data := range [][]int32{{1, 2}, {3, 4}} {
+		tensor, raw, err := NewInput(fmt.Sprintf("INPUT%d", i), []int64{1, 2}, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs = append(inputs, RawInput{Tensor: tensor, Contents: raw})
+	}
+	req, err := NewRequestBuilder("simple", "").
+		WithRawInputs(inputs...).
+		WithInput("INPUT2", "INT32", []int64{1, 1}, []int32{5}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	for i, want := range []int32{1, 3, 5} {
+		if name := fmt.Sprintf("INPUT%d", i); req.Inputs[i].Name != name {
+			t.Errorf("input %d is %s, want %s", i, req.Inputs[i].Name, name)
+		}
+		if got := DecodeInt32(req.RawInputContents[i])[0]; got != want {
+			t.Errorf("raw contents %d start with %d, want %d", i, got, want)
+		}
+	}
+
+	if _, err := NewRequestBuilder("simple", "").WithRawInputs(RawInput{Contents: []byte{1}}).Build(); err == nil {
+		t.Error("Build with a raw input missing its tensor succeeded")
+	}
+}
+
+func TestRequestBuilderSequence(t *testing.T) {
+	req, err := NewRequestBuilder("accumulate", "").
+		WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).
+		WithSequence(SequenceOptions{SequenceID: 42, End: true}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if got := req.Parameters["sequence_id"].GetInt64Param(); got != 42 {
+		t.Errorf("sequence_id = %d, want 42", got)
+	}
+	if req.Parameters["sequence_start"].GetBoolParam() {
+		t.Error("sequence_start = true, want false")
+	}
+	if !req.Parameters["sequence_end"].GetBoolParam() {
+		t.Error("sequence_end = false, want true")
+	}
+}
+
+func TestRequestBuilderScheduling(t *testing.T) {
+	req, err := NewRequestBuilder("simple", "").
+		WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).
+		WithPriority(2).
+		WithRequestTimeout(1500 * time.Millisecond).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if got := req.Parameters["priority"].GetInt64Param(); got != 2 {
+		t.Errorf("priority = %d, want 2", got)
+	}
+	if got := req.Parameters["timeout"].GetInt64Param(); got != 1500000 {
+		t.Errorf("timeout = %d, want 1500000", got)
+	}
+}
+
+func TestRequestBuilderClassification(t *testing.T) {
+	req, err := NewRequestBuilder("resnet", "").
+		WithInput("INPUT0", "FP32", []int64{1}, []float32{1}).
+		WithClassificationOutput("OUTPUT0", 5).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if got := req.Outputs[0].Parameters["classification"].GetInt64Param(); got != 5 {
+		t.Errorf("classification = %d, want 5", got)
+	}
+}
+
+func TestRequestBuilderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *RequestBuilder
+	}{
+		{"no model", NewRequestBuilder("", "").WithInput("INPUT0", "INT32", []int64{1}, []int32{1})},
+		{"no inputs", NewRequestBuilder("simple", "")},
+		{"non-numeric version", NewRequestBuilder("simple", "latest").WithInput("INPUT0", "INT32", []int64{1}, []int32{1})},
+		{"wrong data type", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1}, []float32{1})},
+		{"unsupported datatype", NewRequestBuilder("simple", "").WithInput("INPUT0", "COMPLEX", []int64{1}, []int32{1})},
+		{"duplicate input", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).WithInput("INPUT0", "INT32", []int64{1}, []int32{2})},
+		{"duplicate output", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).WithOutput("OUTPUT0").WithOutput("OUTPUT0")},
+		{"zero dimension", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1, 0}, []int32{})},
+		{"negative dimension", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{-2}, []int32{1})},
+		{"batch mismatch", NewRequestBuilder("simple", "").WithBatchSize(2).WithInput("INPUT0", "INT32", []int64{1, 1}, []int32{1})},
+		{"bad parameter", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).WithParameter("p", 1.5)},
+		{"negative priority", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).WithPriority(-1)},
+		{"zero classification count", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).WithClassificationOutput("OUTPUT0", 0)},
+		{"sub-microsecond timeout", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).WithRequestTimeout(time.Nanosecond)},
+		{"zero sequence id", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).WithSequence(SequenceOptions{Start: true})},
+		{"idle single-request sequence", NewRequestBuilder("simple", "").WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).WithSingleRequestSequence(SequenceOptions{SequenceID: 1})},
+	}
+	for _, tt := range tests {
+		if _, err := tt.builder.Build(); err == nil {
+			t.Errorf("%s: Build succeeded, want error", tt.name)
+		}
+	}
+}
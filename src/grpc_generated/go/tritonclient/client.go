@@ -0,0 +1,268 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package tritonclient wraps the generated Triton gRPC stubs with a
+// reusable client.
+package tritonclient
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+// TritonClient holds a connection to a Triton Inference Server.
+type TritonClient struct {
+	conn     *connPool
+	client   triton.GRPCInferenceServiceClient
+	creds    *tlsCredentials
+	limit    inferLimiter
+	metadata *metadataCache
+	configs  *metadataCache
+	timeout  time.Duration
+	metrics  Metrics
+	calls    *callTracker
+
+	validateBatchSize bool
+	validateInputs    bool
+}
+
+// DefaultTimeout is the longest a call may take, unless changed with
+// WithDefaultTimeout.
+const DefaultTimeout = 10 * time.Second
+
+// Option configures a TritonClient.
+type Option func(*options)
+
+type options struct {
+	tlsConfig          *tls.Config
+	tlsFiles           *tlsFiles
+	tlsServerName      string
+	minTLSVersion      uint16
+	cipherSuites       []uint16
+	unaryInterceptors  []grpc.UnaryClientInterceptor
+	streamInterceptors []grpc.StreamClientInterceptor
+	maxConcurrent      int
+	modelCompression   map[string]string
+	maxConnAge         time.Duration
+	connAgeGrace       time.Duration
+	dialOptions        []grpc.DialOption
+	metadataCache      bool
+	metadataTTL        time.Duration
+	timeout            time.Duration
+	validateBatchSize  bool
+	validateInputs     bool
+	logger             Logger
+	traceIDs           bool
+	metrics            Metrics
+	poolSize           int
+	reconnectAfter     time.Duration
+}
+
+// WithDefaultTimeout sets the longest a call may take. A deadline already
+// on the caller's context still applies when it is earlier, so upstream
+// deadlines flow through; the call ends at whichever comes first. Zero
+// removes the limit, leaving calls bounded only by their context.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithDialOptions passes extra options to grpc.Dial. This is the place
+// for grpc.WithChainUnaryInterceptor and grpc.WithChainStreamInterceptor,
+// e.g. to attach OpenTelemetry or Prometheus instrumentation; such
+// interceptors run after the client's own, once per attempt when
+// WithRetry retries a call.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// NewTritonClient connects to the server at url. The connection is
+// insecure unless a TLS option is given. url may also be a comma-separated
+// list of endpoints, in which case the client uses the first reachable one
+// and fails over to the next when the connection is lost, or a
+// unix:///path/to/sock URL to connect over a Unix domain socket.
+func NewTritonClient(url string, opts ...Option) (*TritonClient, error) {
+	o := options{timeout: DefaultTimeout, logger: nopLogger{}, metrics: nopMetrics{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	for model, name := range o.modelCompression {
+		if encoding.GetCompressor(name) == nil {
+			return nil, fmt.Errorf("compressor %q for model %s is not registered", name, model)
+		}
+	}
+	o.unaryInterceptors = append(o.unaryInterceptors, compressionInterceptor(o.modelCompression))
+	if o.traceIDs {
+		unary, stream := traceIDInterceptors(o.logger)
+		o.unaryInterceptors = append(o.unaryInterceptors, unary)
+		o.streamInterceptors = append(o.streamInterceptors, stream)
+	}
+
+	c := &TritonClient{
+		limit:    newInferLimiter(o.maxConcurrent),
+		metadata: newMetadataCache(o.metadataCache || o.validateInputs, o.metadataTTL),
+		configs:  newMetadataCache(o.metadataCache || o.validateBatchSize, o.metadataTTL),
+		timeout:  o.timeout,
+		metrics:  o.metrics,
+		calls:    newCallTracker(),
+
+		validateBatchSize: o.validateBatchSize,
+		validateInputs:    o.validateInputs,
+	}
+	tlsConfig, err := o.buildTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	var dialOpts []grpc.DialOption
+	if tlsConfig != nil {
+		c.creds = newTLSCredentials(tlsConfig, o.minTLSVersion)
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(c.creds))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+	// Calls on an already-done context fail before any other interceptor
+	// runs or the RPC is issued. Calls are then counted for Shutdown.
+	unary := append([]grpc.UnaryClientInterceptor{contextErrUnaryInterceptor, c.calls.unaryInterceptor}, o.unaryInterceptors...)
+	stream := append([]grpc.StreamClientInterceptor{contextErrStreamInterceptor, c.calls.streamInterceptor}, o.streamInterceptors...)
+	dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(unary...), grpc.WithChainStreamInterceptor(stream...))
+
+	target := url
+	if path, ok := unixSocketPath(url); ok {
+		var unixOpts []grpc.DialOption
+		target, unixOpts = unixTarget(path)
+		dialOpts = append(dialOpts, unixOpts...)
+	} else if urls := strings.Split(url, ","); len(urls) > 1 {
+		var resolverOpt grpc.DialOption
+		target, resolverOpt, err = failoverTarget(urls)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint list %q: %w", url, err)
+		}
+		dialOpts = append(dialOpts, resolverOpt)
+	}
+
+	dialOpts = append(dialOpts, o.dialOptions...)
+
+	conn, err := newConnPool(o.poolSize, func() (*managedConn, error) {
+		return newManagedConn(func() (*grpc.ClientConn, error) {
+			return grpc.Dial(target, dialOpts...)
+		}, o.maxConnAge, o.connAgeGrace, o.reconnectAfter, o.logger)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to endpoint %s: %w", url, err)
+	}
+	c.conn = conn
+	c.client = triton.NewGRPCInferenceServiceClient(conn)
+	return c, nil
+}
+
+// GRPCClient returns the generated client bound to the connection.
+func (c *TritonClient) GRPCClient() triton.GRPCInferenceServiceClient {
+	return c.client
+}
+
+// Close closes the underlying connections.
+func (c *TritonClient) Close() error {
+	return c.conn.Close()
+}
+
+// contextErrUnaryInterceptor returns ctx.Err() unchanged, without issuing
+// the RPC, when ctx is already cancelled or past its deadline.
+func contextErrUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// contextErrStreamInterceptor is the streaming counterpart of
+// contextErrUnaryInterceptor.
+func contextErrStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// requestContext bounds ctx by the client's default timeout. Every RPC
+// goes through it, so the effective deadline is the earlier of the
+// caller's and the default.
+func (c *TritonClient) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// Live reports whether the server is live.
+func (c *TritonClient) Live(ctx context.Context) (bool, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	resp, err := c.client.ServerLive(ctx, &triton.ServerLiveRequest{})
+	if err != nil {
+		return false, err
+	}
+	return resp.Live, nil
+}
+
+// Ready reports whether the server is ready to serve inferences.
+func (c *TritonClient) Ready(ctx context.Context) (bool, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	resp, err := c.client.ServerReady(ctx, &triton.ServerReadyRequest{})
+	if err != nil {
+		return false, err
+	}
+	return resp.Ready, nil
+}
+
+// ModelReady reports whether a model version is loaded and ready to serve
+// inferences. An empty version means the version the server picks. The
+// server can be ready while individual models are still loading.
+func (c *TritonClient) ModelReady(ctx context.Context, name, version string) (bool, error) {
+	version, err := normalizeVersion(version)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	resp, err := c.client.ModelReady(ctx, &triton.ModelReadyRequest{Name: name, Version: version})
+	if err != nil {
+		return false, err
+	}
+	return resp.Ready, nil
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+)
+
+func TestLiveAndReady(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+		serverReady: func(context.Context, *triton.ServerReadyRequest) (*triton.ServerReadyResponse, error) {
+			return &triton.ServerReadyResponse{Ready: false}, nil
+		},
+	})
+
+	live, err := client.Live(context.Background())
+	if err != nil || !live {
+		t.Errorf("Live = %v, %v; want true", live, err)
+	}
+	ready, err := client.Ready(context.Background())
+	if err != nil || ready {
+		t.Errorf("Ready = %v, %v; want false", ready, err)
+	}
+}
+
+func TestServerMetadata(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		serverMetadata: func(context.Context, *triton.ServerMetadataRequest) (*triton.ServerMetadataResponse, error) {
+			return &triton.ServerMetadataResponse{
+				Name:       "triton",
+				Version:    "2.40.0",
+				Extensions: []string{"classification", "system_shared_memory"},
+			}, nil
+		},
+	})
+
+	metadata, err := client.ServerMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("ServerMetadata: %v", err)
+	}
+	if metadata.Version != "2.40.0" {
+		t.Errorf("Version = %q", metadata.Version)
+	}
+	if !HasExtension(metadata, "system_shared_memory") {
+		t.Error("system_shared_memory extension not found")
+	}
+	if HasExtension(metadata, "cuda_shared_memory") {
+		t.Error("cuda_shared_memory extension found")
+	}
+}
+
+func TestModelReady(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		modelReady: func(_ context.Context, req *triton.ModelReadyRequest) (*triton.ModelReadyResponse, error) {
+			return &triton.ModelReadyResponse{Ready: req.Name == "simple" && req.Version == "1"}, nil
+		},
+	})
+
+	if ready, err := client.ModelReady(context.Background(), "simple", "1"); err != nil || !ready {
+		t.Errorf("ModelReady(simple, 1) = %v, %v; want true", ready, err)
+	}
+	if ready, err := client.ModelReady(context.Background(), "simple", "2"); err != nil || ready {
+		t.Errorf("ModelReady(simple, 2) = %v, %v; want false", ready, err)
+	}
+}
+
+func TestDefaultTimeout(t *testing.T) {
+	deadlines := make(chan time.Duration, 2)
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(ctx context.Context, _ *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				deadlines <- 0
+			} else {
+				deadlines <- time.Until(deadline)
+			}
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	}, WithDefaultTimeout(time.Minute))
+
+	if _, err := client.Live(context.Background()); err != nil {
+		t.Fatalf("Live: %v", err)
+	}
+	if d := <-deadlines; d <= 50*time.Second || d > time.Minute {
+		t.Errorf("default deadline in %v, want about a minute", d)
+	}
+
+	// An earlier deadline on the caller's context wins over the default.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := client.Live(ctx); err != nil {
+		t.Fatalf("Live: %v", err)
+	}
+	if d := <-deadlines; d > 5*time.Second {
+		t.Errorf("deadline in %v, want at most 5s", d)
+	}
+
+	// A later one is cut short by it.
+	ctx, cancel = context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	if _, err := client.Live(ctx); err != nil {
+		t.Fatalf("Live: %v", err)
+	}
+	if d := <-deadlines; d > time.Minute {
+		t.Errorf("deadline in %v, want at most a minute", d)
+	}
+}
+
+func TestCancelledContext(t *testing.T) {
+	called := false
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			called = true
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Live(ctx); err != context.Canceled {
+		t.Errorf("Live on a cancelled context: %v, want context.Canceled", err)
+	}
+	if _, err := client.GRPCClient().ServerLive(ctx, &triton.ServerLiveRequest{}); err != context.Canceled {
+		t.Errorf("ServerLive on a cancelled context: %v, want context.Canceled", err)
+	}
+	if called {
+		t.Error("server received a call on a cancelled context")
+	}
+}
+
+func TestDialOptionInterceptors(t *testing.T) {
+	var unary, stream []string
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+		modelStreamInfer: func(triton.GRPCInferenceService_ModelStreamInferServer) error {
+			return nil
+		},
+	}, WithDialOptions(
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			unary = append(unary, method)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			stream = append(stream, method)
+			return streamer(ctx, desc, cc, method, opts...)
+		}),
+	))
+
+	if _, err := client.Live(context.Background()); err != nil {
+		t.Fatalf("Live: %v", err)
+	}
+	s, err := client.StreamInfer(context.Background())
+	if err != nil {
+		t.Fatalf("StreamInfer: %v", err)
+	}
+	s.Close()
+
+	if len(unary) != 1 || !strings.HasSuffix(unary[0], "/ServerLive") {
+		t.Errorf("unary interceptor saw %v", unary)
+	}
+	if len(stream) != 1 || !strings.HasSuffix(stream[0], "/ModelStreamInfer") {
+		t.Errorf("stream interceptor saw %v", stream)
+	}
+}
+
+func TestUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "triton.sock")
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := grpc.NewServer()
+	triton.RegisterGRPCInferenceServiceServer(s, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	})
+	go s.Serve(lis)
+	defer s.Stop()
+
+	for _, url := range []string{"unix://" + path, "unix:" + path} {
+		client, err := NewTritonClient(url)
+		if err != nil {
+			t.Fatalf("NewTritonClient(%s): %v", url, err)
+		}
+		if live, err := client.Live(context.Background()); err != nil || !live {
+			t.Errorf("Live over %s = %v, %v", url, live, err)
+		}
+		client.Close()
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+
+	// Registering the gzip compressor lets the client transparently decode
+	// gzip-compressed responses even when it didn't compress the request.
+	// Registration is process-wide.
+	_ "google.golang.org/grpc/encoding/gzip"
+)
+
+// WithModelCompression compresses ModelInfer requests for model with the
+// named compressor (e.g. "gzip"). Requests for other models are sent
+// uncompressed.
+func WithModelCompression(model, compressor string) Option {
+	return func(o *options) {
+		if o.modelCompression == nil {
+			o.modelCompression = make(map[string]string)
+		}
+		o.modelCompression[model] = compressor
+	}
+}
+
+type compressorKey struct{}
+
+// ContextWithCompressor returns a context whose requests are compressed
+// with the named compressor (e.g. "gzip"), overriding WithModelCompression
+// for that call. Compression pays off for multi-megabyte batches but adds
+// latency to small requests, so it is best chosen per call. Use "identity"
+// to send a request uncompressed. The compressor must be registered, or
+// the call fails.
+func ContextWithCompressor(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, compressorKey{}, name)
+}
+
+// compressionInterceptor adds a UseCompressor call option to requests
+// whose context names a compressor, and otherwise to ModelInfer requests
+// whose model has a compressor configured.
+func compressionInterceptor(compressors map[string]string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if name, ok := ctx.Value(compressorKey{}).(string); ok {
+			opts = append(opts, grpc.UseCompressor(name))
+		} else if inferReq, ok := req.(*triton.ModelInferRequest); ok {
+			if name, ok := compressors[inferReq.ModelName]; ok {
+				opts = append(opts, grpc.UseCompressor(name))
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
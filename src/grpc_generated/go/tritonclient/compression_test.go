@@ -0,0 +1,134 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/stats"
+)
+
+func TestGzipCompressedResponse(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(ctx context.Context, _ *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			if err := grpc.SetSendCompressor(ctx, gzip.Name); err != nil {
+				return nil, err
+			}
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	})
+
+	resp, err := client.GRPCClient().ServerLive(context.Background(), &triton.ServerLiveRequest{})
+	if err != nil {
+		t.Fatalf("ServerLive: %v", err)
+	}
+	if !resp.Live {
+		t.Error("Live = false, want true")
+	}
+}
+
+// compressionRecorder is a server stats handler recording the compression
+// of each incoming request.
+type compressionRecorder struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (r *compressionRecorder) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (r *compressionRecorder) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if h, ok := s.(*stats.InHeader); ok {
+		r.mu.Lock()
+		r.names = append(r.names, h.Compression)
+		r.mu.Unlock()
+	}
+}
+
+func (r *compressionRecorder) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *compressionRecorder) HandleConn(context.Context, stats.ConnStats) {}
+
+func TestContextWithCompressor(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	rec := &compressionRecorder{}
+	s := grpc.NewServer(grpc.StatsHandler(rec))
+	triton.RegisterGRPCInferenceServiceServer(s, &fakeServer{
+		modelInfer: func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			return &triton.ModelInferResponse{}, nil
+		},
+	})
+	go s.Serve(lis)
+	defer s.Stop()
+
+	client, err := NewTritonClient(lis.Addr().String(), WithModelCompression("big", gzip.Name))
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	calls := []struct {
+		ctx   context.Context
+		model string
+	}{
+		{ctx, "small"},
+		{ContextWithCompressor(ctx, gzip.Name), "small"},
+		{ctx, "big"},
+		{ContextWithCompressor(ctx, "identity"), "big"},
+	}
+	for _, call := range calls {
+		if _, err := client.Infer(call.ctx, &triton.ModelInferRequest{ModelName: call.model}); err != nil {
+			t.Fatalf("Infer(%s): %v", call.model, err)
+		}
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	want := []string{"", gzip.Name, gzip.Name, "identity"}
+	if len(rec.names) != len(want) {
+		t.Fatalf("server saw %d requests, want %d", len(rec.names), len(want))
+	}
+	for i := range want {
+		if rec.names[i] != want[i] {
+			t.Errorf("request %d compression = %q, want %q", i, rec.names[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/keepalive"
+)
+
+// WithMaxConnectionAge re-dials the server once the connection is older
+// than maxAge, so long-running clients don't hold on to connections that
+// NATs and load balancers have silently broken. The replaced connection is
+// closed after grace, or minConnGrace if that is longer, to let in-flight
+// calls finish.
+func WithMaxConnectionAge(maxAge, grace time.Duration) Option {
+	return func(o *options) {
+		o.maxConnAge = maxAge
+		o.connAgeGrace = grace
+	}
+}
+
+// WithIdleTimeout lets the connection go idle, closing its transports,
+// after d without RPCs. The next RPC reconnects.
+func WithIdleTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, grpc.WithIdleTimeout(d))
+	}
+}
+
+// WithMaxMessageSize raises the largest response the client accepts to
+// recv bytes and the largest request it sends to send bytes. gRPC limits
+// received messages to 4MB by default, which large batches can exceed. A
+// zero size keeps the gRPC default.
+func WithMaxMessageSize(recv, send int) Option {
+	return func(o *options) {
+		var callOpts []grpc.CallOption
+		if recv > 0 {
+			callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(recv))
+		}
+		if send > 0 {
+			callOpts = append(callOpts, grpc.MaxCallSendMsgSize(send))
+		}
+		o.dialOptions = append(o.dialOptions, grpc.WithDefaultCallOptions(callOpts...))
+	}
+}
+
+// WithKeepalive pings the server as configured by params so idle
+// connections stay open through NAT and load balancer idle timeouts,
+// rather than failing the first call after a quiet period. The server's
+// keepalive enforcement policy must permit the ping interval, or it will
+// close the connection.
+func WithKeepalive(params keepalive.ClientParameters) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, grpc.WithKeepaliveParams(params))
+	}
+}
+
+// WithReconnect watches the connection state in the background and
+// re-dials a connection that has stayed in TransientFailure for after.
+// gRPC retries a failed connection on its own, but with a backoff that
+// grows to minutes, so a client can stay broken long after a restarted
+// server is back. Re-dialing also resolves the target again.
+func WithReconnect(after time.Duration) Option {
+	return func(o *options) {
+		o.reconnectAfter = after
+	}
+}
+
+// State reports the connectivity state of the client's connection. With
+// WithConnPool it reports the first connection that isn't Ready, or Ready
+// if they all are.
+func (c *TritonClient) State() connectivity.State {
+	return c.conn.state()
+}
+
+// minConnGrace is the least time a replaced connection is kept open, so
+// calls already in flight on it aren't cut off even with a zero grace.
+const minConnGrace = time.Second
+
+// managedConn is the connection the generated client is bound to. It
+// forwards calls to the current *grpc.ClientConn and replaces that
+// connection once it exceeds the maximum age, or with WithReconnect once
+// it has been failing for too long.
+type managedConn struct {
+	dial   func() (*grpc.ClientConn, error)
+	maxAge time.Duration
+	grace  time.Duration
+	log    Logger
+	cancel context.CancelFunc
+
+	mu        sync.Mutex
+	conn      *grpc.ClientConn
+	created   time.Time
+	closed    bool
+	replacing bool // a replacement is being dialed
+}
+
+func newManagedConn(dial func() (*grpc.ClientConn, error), maxAge, grace, reconnectAfter time.Duration, logger Logger) (*managedConn, error) {
+	conn, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &managedConn{dial: dial, maxAge: maxAge, grace: grace, log: logger, cancel: cancel, conn: conn, created: time.Now()}
+	if reconnectAfter > 0 {
+		go m.monitor(ctx, reconnectAfter)
+	}
+	return m, nil
+}
+
+// current returns the connection to use, re-dialing first if it is too
+// old. If re-dialing fails the old connection is kept. Calls made while
+// another call is re-dialing use the old connection rather than wait.
+func (m *managedConn) current() *grpc.ClientConn {
+	m.mu.Lock()
+	conn := m.conn
+	if m.closed || m.replacing || m.maxAge <= 0 || time.Since(m.created) < m.maxAge {
+		m.mu.Unlock()
+		return conn
+	}
+	m.replacing = true
+	m.mu.Unlock()
+
+	m.replace(conn, fmt.Sprintf("older than %v", m.maxAge))
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.conn
+}
+
+// replace re-dials and swaps the new connection in for old, closing old
+// after the grace period, or minConnGrace if that is longer. The caller
+// must have set m.replacing; m.mu must not be held, so that a slow dial,
+// e.g. with grpc.WithBlock, doesn't hold up other calls.
+func (m *managedConn) replace(old *grpc.ClientConn, reason string) {
+	conn, err := m.dial()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.replacing = false
+	if err != nil {
+		m.log.Errorf("couldn't replace connection %s, keeping it: %v", reason, err)
+		return
+	}
+	if m.closed || m.conn != old {
+		conn.Close()
+		return
+	}
+	m.log.Debugf("replaced connection %s", reason)
+	m.conn, m.created = conn, time.Now()
+	time.AfterFunc(max(m.grace, minConnGrace), func() { old.Close() })
+}
+
+// monitor re-dials the connection whenever it has been in
+// TransientFailure for after, until ctx is cancelled.
+func (m *managedConn) monitor(ctx context.Context, after time.Duration) {
+	for {
+		m.mu.Lock()
+		conn := m.conn
+		m.mu.Unlock()
+
+		state := conn.GetState()
+		if state != connectivity.TransientFailure {
+			if !conn.WaitForStateChange(ctx, state) {
+				return
+			}
+			continue
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, after)
+		changed := conn.WaitForStateChange(waitCtx, state)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if changed {
+			continue
+		}
+		m.mu.Lock()
+		replace := !m.closed && !m.replacing && m.conn == conn
+		if replace {
+			m.replacing = true
+		}
+		m.mu.Unlock()
+		if replace {
+			m.replace(conn, fmt.Sprintf("in %v for %v", state, after))
+		}
+	}
+}
+
+func (m *managedConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	return m.current().Invoke(ctx, method, args, reply, opts...)
+}
+
+func (m *managedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return m.current().NewStream(ctx, desc, method, opts...)
+}
+
+func (m *managedConn) Close() error {
+	m.cancel()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	return m.conn.Close()
+}
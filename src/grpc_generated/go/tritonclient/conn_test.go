@@ -0,0 +1,367 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestMaxConnectionAge(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	}, WithMaxConnectionAge(10*time.Millisecond, 0))
+
+	first := client.conn.conns[0].current()
+	time.Sleep(20 * time.Millisecond)
+	if _, err := client.GRPCClient().ServerLive(context.Background(), &triton.ServerLiveRequest{}); err != nil {
+		t.Fatalf("ServerLive after max age: %v", err)
+	}
+	if client.conn.conns[0].current() == first {
+		t.Error("connection was not replaced after its max age")
+	}
+}
+
+func TestMaxConnectionAgeInFlight(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			started <- struct{}{}
+			<-release
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+		serverReady: func(context.Context, *triton.ServerReadyRequest) (*triton.ServerReadyResponse, error) {
+			return &triton.ServerReadyResponse{Ready: true}, nil
+		},
+	}, WithMaxConnectionAge(10*time.Millisecond, 0))
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := client.Live(context.Background())
+		errc <- err
+	}()
+	<-started
+	first := client.conn.conns[0].current()
+	time.Sleep(20 * time.Millisecond)
+
+	// Replacing the connection with a zero grace must not cut off the call
+	// still in flight on the old one.
+	if _, err := client.Ready(context.Background()); err != nil {
+		t.Fatalf("Ready after max age: %v", err)
+	}
+	if client.conn.conns[0].current() == first {
+		t.Fatal("connection was not replaced after its max age")
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	if err := <-errc; err != nil {
+		t.Errorf("in-flight Live across the replacement: %v", err)
+	}
+}
+
+func TestMaxConnectionAgeSlowDial(t *testing.T) {
+	var dials int32
+	release := make(chan struct{})
+	dial := func() (*grpc.ClientConn, error) {
+		if atomic.AddInt32(&dials, 1) > 1 {
+			<-release
+		}
+		return grpc.Dial("127.0.0.1:1", grpc.WithInsecure())
+	}
+	m, err := newManagedConn(dial, 10*time.Millisecond, 0, 0, nopLogger{})
+	if err != nil {
+		t.Fatalf("newManagedConn: %v", err)
+	}
+	defer m.Close()
+	first := m.current()
+	time.Sleep(20 * time.Millisecond)
+
+	replaced := make(chan *grpc.ClientConn)
+	go func() { replaced <- m.current() }()
+	for atomic.LoadInt32(&dials) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// While the replacement is still dialing, other calls keep using the
+	// old connection instead of waiting on the lock.
+	done := make(chan *grpc.ClientConn)
+	go func() { done <- m.current() }()
+	select {
+	case conn := <-done:
+		if conn != first {
+			t.Error("call during the re-dial didn't use the old connection")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("call blocked while the replacement was dialing")
+	}
+
+	close(release)
+	if conn := <-replaced; conn == first {
+		t.Error("connection was not replaced after the dial completed")
+	}
+	if n := atomic.LoadInt32(&dials); n != 2 {
+		t.Errorf("dialed %d times, want 2", n)
+	}
+}
+
+func TestMaxMessageSize(t *testing.T) {
+	srv := &fakeServer{
+		modelInfer: func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			return &triton.ModelInferResponse{RawOutputContents: [][]byte{make([]byte, 5<<20)}}, nil
+		},
+	}
+
+	client := startFakeServer(t, srv)
+	_, err := client.Infer(context.Background(), &triton.ModelInferRequest{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Infer with the default limit: %v, want ResourceExhausted", err)
+	}
+
+	client = startFakeServer(t, srv, WithMaxMessageSize(8<<20, 0))
+	resp, err := client.Infer(context.Background(), &triton.ModelInferRequest{})
+	if err != nil {
+		t.Fatalf("Infer with an 8MB limit: %v", err)
+	}
+	if n := len(resp.RawOutputContents[0]); n != 5<<20 {
+		t.Errorf("received %d bytes, want %d", n, 5<<20)
+	}
+}
+
+func TestConnPool(t *testing.T) {
+	var mu sync.Mutex
+	peers := make(map[string]int)
+	srv := &fakeServer{
+		modelInfer: func(ctx context.Context, _ *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			p, _ := peer.FromContext(ctx)
+			mu.Lock()
+			peers[p.Addr.String()]++
+			mu.Unlock()
+			return &triton.ModelInferResponse{}, nil
+		},
+	}
+
+	client := startFakeServer(t, srv, WithConnPool(3))
+	for i := 0; i < 9; i++ {
+		if _, err := client.Infer(context.Background(), &triton.ModelInferRequest{}); err != nil {
+			t.Fatalf("Infer: %v", err)
+		}
+	}
+
+	if len(peers) != 3 {
+		t.Fatalf("calls arrived over %d connections, want 3: %v", len(peers), peers)
+	}
+	for addr, n := range peers {
+		if n != 3 {
+			t.Errorf("connection %s carried %d calls, want 3", addr, n)
+		}
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := lis.Addr().String()
+	srv := &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	}
+	s := grpc.NewServer()
+	triton.RegisterGRPCInferenceServiceServer(s, srv)
+	go s.Serve(lis)
+
+	client, err := NewTritonClient(addr, WithReconnect(20*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	defer client.Close()
+	if _, err := client.Live(context.Background()); err != nil {
+		t.Fatalf("Live: %v", err)
+	}
+	if state := client.State(); state != connectivity.Ready {
+		t.Fatalf("State = %v, want Ready", state)
+	}
+	first := client.conn.conns[0].current()
+
+	// Take the server down. A stopped server leaves the connection idle,
+	// so keep calling until it fails to connect and is re-dialed.
+	s.Stop()
+	deadline := time.Now().Add(5 * time.Second)
+	for client.conn.conns[0].current() == first {
+		if time.Now().After(deadline) {
+			t.Fatalf("connection in %v was not re-dialed", client.State())
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		client.Live(ctx)
+		cancel()
+	}
+
+	// Once the server is back, the client works without being recreated.
+	lis, err = net.Listen("tcp", addr)
+	if err != nil {
+		t.Fatalf("listen again: %v", err)
+	}
+	s = grpc.NewServer()
+	triton.RegisterGRPCInferenceServiceServer(s, srv)
+	go s.Serve(lis)
+	defer s.Stop()
+	deadline = time.Now().Add(5 * time.Second)
+	for {
+		_, err := client.Live(context.Background())
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Live after restart: %v", err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// pingCountingListener counts the HTTP/2 PING frames, other than acks,
+// that clients send over accepted connections.
+type pingCountingListener struct {
+	net.Listener
+	mu    sync.Mutex
+	pings int
+}
+
+func (l *pingCountingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &pingCountingConn{Conn: conn, l: l}, nil
+}
+
+func (l *pingCountingListener) count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.pings
+}
+
+type pingCountingConn struct {
+	net.Conn
+	l *pingCountingListener
+	// buf holds bytes read but not yet parsed into whole frames.
+	buf        []byte
+	sawPreface bool
+}
+
+func (c *pingCountingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.buf = append(c.buf, p[:n]...)
+	if !c.sawPreface {
+		if len(c.buf) < len(http2Preface) {
+			return n, err
+		}
+		c.buf = c.buf[len(http2Preface):]
+		c.sawPreface = true
+	}
+	const headerLen = 9
+	for len(c.buf) >= headerLen {
+		length := int(c.buf[0])<<16 | int(c.buf[1])<<8 | int(c.buf[2])
+		if len(c.buf) < headerLen+length {
+			break
+		}
+		const framePing, flagAck = 0x6, 0x1
+		if c.buf[3] == framePing && c.buf[4]&flagAck == 0 {
+			c.l.mu.Lock()
+			c.l.pings++
+			c.l.mu.Unlock()
+		}
+		c.buf = c.buf[headerLen+length:]
+	}
+	return n, err
+}
+
+const http2Preface = "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n"
+
+func TestKeepalive(t *testing.T) {
+	if testing.Short() {
+		t.Skip("gRPC pings no more often than every 10s")
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	counter := &pingCountingListener{Listener: lis}
+	s := grpc.NewServer(grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             time.Second,
+		PermitWithoutStream: true,
+	}))
+	triton.RegisterGRPCInferenceServiceServer(s, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	})
+	go s.Serve(counter)
+	defer s.Stop()
+
+	client, err := NewTritonClient(lis.Addr().String(), WithKeepalive(keepalive.ClientParameters{
+		Time:                10 * time.Second,
+		Timeout:             time.Second,
+		PermitWithoutStream: true,
+	}))
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	defer client.Close()
+	if _, err := client.Live(context.Background()); err != nil {
+		t.Fatalf("Live: %v", err)
+	}
+
+	// Let any pings from the call itself arrive, then stay idle past the
+	// keepalive interval.
+	time.Sleep(100 * time.Millisecond)
+	before := counter.count()
+	time.Sleep(11 * time.Second)
+	if after := counter.count(); after <= before {
+		t.Errorf("idle connection sent %d pings, want at least one", after-before)
+	}
+	if _, err := client.Live(context.Background()); err != nil {
+		t.Errorf("Live after idling: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"fmt"
+
+	triton "nvidia_inferenceserver"
+)
+
+// decodeContents returns the typed contents the server sent inline in
+// output, rather than in the response's raw output contents, converted to
+// the same slice types InferResult.Output returns for raw contents. It is
+// an error for the contents to hold other than the number of elements in
+// the output's shape.
+func decodeContents(output *triton.ModelInferResponse_InferOutputTensor) (interface{}, error) {
+	contents := output.Contents
+	if contents == nil {
+		return nil, fmt.Errorf("output %s has no contents", output.Name)
+	}
+	var decoded interface{}
+	var count int
+	switch output.Datatype {
+	case "BOOL":
+		decoded, count = contents.BoolContents, len(contents.BoolContents)
+	case "INT8":
+		values := make([]int8, len(contents.IntContents))
+		for i, v := range contents.IntContents {
+			values[i] = int8(v)
+		}
+		decoded, count = values, len(values)
+	case "INT16":
+		values := make([]int16, len(contents.IntContents))
+		for i, v := range contents.IntContents {
+			values[i] = int16(v)
+		}
+		decoded, count = values, len(values)
+	case "INT32":
+		decoded, count = contents.IntContents, len(contents.IntContents)
+	case "INT64":
+		decoded, count = contents.Int64Contents, len(contents.Int64Contents)
+	case "UINT8":
+		values := make([]uint8, len(contents.UintContents))
+		for i, v := range contents.UintContents {
+			values[i] = uint8(v)
+		}
+		decoded, count = values, len(values)
+	case "UINT16":
+		values := make([]uint16, len(contents.UintContents))
+		for i, v := range contents.UintContents {
+			values[i] = uint16(v)
+		}
+		decoded, count = values, len(values)
+	case "UINT32":
+		decoded, count = contents.UintContents, len(contents.UintContents)
+	case "UINT64":
+		decoded, count = contents.Uint64Contents, len(contents.Uint64Contents)
+	case "FP32":
+		decoded, count = contents.Fp32Contents, len(contents.Fp32Contents)
+	case "FP64":
+		decoded, count = contents.Fp64Contents, len(contents.Fp64Contents)
+	case "BYTES":
+		strs := make([]string, len(contents.BytesContents))
+		for i, b := range contents.BytesContents {
+			strs[i] = string(b)
+		}
+		decoded, count = strs, len(strs)
+	default:
+		return nil, fmt.Errorf("output %s has unsupported datatype %s", output.Name, output.Datatype)
+	}
+
+	n := int64(1)
+	for _, dim := range output.Shape {
+		n *= dim
+	}
+	if int64(count) != n {
+		return nil, fmt.Errorf("output %s has %d elements of contents but shape %v holds %d", output.Name, count, output.Shape, n)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+// WriteCSV writes decoded outputs to w as CSV with one row per batch
+// element. outputs[i] is the flattened slice decoded for the output named
+// names[i]; its values are split evenly across batchSize rows and its
+// columns are headed "<name>_<j>".
+func WriteCSV(w io.Writer, names []string, outputs []interface{}, batchSize int) error {
+	if len(names) != len(outputs) {
+		return fmt.Errorf("got %d output names for %d outputs", len(names), len(outputs))
+	}
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid batch size %d", batchSize)
+	}
+
+	values := make([]reflect.Value, len(outputs))
+	widths := make([]int, len(outputs))
+	var header []string
+	for i, output := range outputs {
+		v := reflect.ValueOf(output)
+		if v.Kind() != reflect.Slice {
+			return fmt.Errorf("output %s is a %T, not a slice", names[i], output)
+		}
+		if v.Len()%batchSize != 0 {
+			return fmt.Errorf("output %s has %d values, not divisible by batch size %d", names[i], v.Len(), batchSize)
+		}
+		values[i] = v
+		widths[i] = v.Len() / batchSize
+		for j := 0; j < widths[i]; j++ {
+			header = append(header, names[i]+"_"+strconv.Itoa(j))
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for b := 0; b < batchSize; b++ {
+		row := make([]string, 0, len(header))
+		for i, v := range values {
+			for j := 0; j < widths[i]; j++ {
+				row = append(row, fmt.Sprint(v.Index(b*widths[i]+j).Interface()))
+			}
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteCSV(t *testing.T) {
+	tests := []struct {
+		names     []string
+		outputs   []interface{}
+		batchSize int
+		want      string
+	}{
+		{
+			names:     []string{"OUTPUT0"},
+			outputs:   []interface{}{[]int32{1, 2, 3}},
+			batchSize: 1,
+			want:      "OUTPUT0_0,OUTPUT0_1,OUTPUT0_2\n1,2,3\n",
+		},
+		{
+			names:     []string{"OUTPUT0"},
+			outputs:   []interface{}{[]int32{1, 2, 3, 4}},
+			batchSize: 2,
+			want:      "OUTPUT0_0,OUTPUT0_1\n1,2\n3,4\n",
+		},
+		{
+			names: []string{"PROB", "LABEL", "VALID"},
+			outputs: []interface{}{
+				[]float32{0.5, 0.25},
+				[]string{"cat", "dog,wolf"},
+				[]bool{true, false},
+			},
+			batchSize: 2,
+			want:      "PROB_0,LABEL_0,VALID_0\n0.5,cat,true\n0.25,\"dog,wolf\",false\n",
+		},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := WriteCSV(&buf, tt.names, tt.outputs, tt.batchSize); err != nil {
+			t.Errorf("WriteCSV(%v): %v", tt.names, err)
+			continue
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("WriteCSV(%v) = %q, want %q", tt.names, got, tt.want)
+		}
+	}
+
+	errTests := []struct {
+		names     []string
+		outputs   []interface{}
+		batchSize int
+	}{
+		{[]string{"OUTPUT0", "OUTPUT1"}, []interface{}{[]int32{1, 2}, []int32{1, 2, 3}}, 2},
+		{[]string{"OUTPUT0"}, []interface{}{[]int32{1}, []int32{2}}, 1},
+		{[]string{"OUTPUT0"}, []interface{}{[]int32{1}}, 0},
+		{[]string{"OUTPUT0"}, []interface{}{int32(1)}, 1},
+	}
+	for _, tt := range errTests {
+		if err := WriteCSV(&bytes.Buffer{}, tt.names, tt.outputs, tt.batchSize); err == nil {
+			t.Errorf("WriteCSV(%v, %v, %d) succeeded", tt.names, tt.outputs, tt.batchSize)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// DecodeInt32 converts raw little-endian INT32 tensor contents into a new
+// slice.
+func DecodeInt32(raw []byte) []int32 {
+	dst := make([]int32, len(raw)/4)
+	DecodeInt32Into(raw, dst)
+	return dst
+}
+
+// DecodeInt32Into decodes raw little-endian INT32 tensor contents into dst,
+// which must hold at least len(raw)/4 elements. Reusing dst across calls
+// avoids an allocation per decode.
+func DecodeInt32Into(raw []byte, dst []int32) error {
+	return decodeInt32Into(raw, dst, binary.LittleEndian)
+}
+
+// DecodeInt32WithByteOrder converts INT32 data stored in order into a new
+// slice. Server responses are always little-endian; other orders are for
+// data from other sources.
+func DecodeInt32WithByteOrder(raw []byte, order binary.ByteOrder) []int32 {
+	dst := make([]int32, len(raw)/4)
+	decodeInt32Into(raw, dst, order)
+	return dst
+}
+
+func decodeInt32Into(raw []byte, dst []int32, order binary.ByteOrder) error {
+	n := len(raw) / 4
+	if len(dst) < n {
+		return fmt.Errorf("destination holds %d elements, need %d", len(dst), n)
+	}
+	for i := 0; i < n; i++ {
+		dst[i] = int32(order.Uint32(raw[i*4:]))
+	}
+	return nil
+}
+
+// DecodeFloat32 converts raw little-endian FP32 tensor contents into a new
+// slice.
+func DecodeFloat32(raw []byte) []float32 {
+	dst := make([]float32, len(raw)/4)
+	DecodeFloat32Into(raw, dst)
+	return dst
+}
+
+// DecodeFloat32Into decodes raw little-endian FP32 tensor contents into
+// dst, which must hold at least len(raw)/4 elements. Reusing dst across
+// calls avoids an allocation per decode.
+func DecodeFloat32Into(raw []byte, dst []float32) error {
+	return decodeFloat32Into(raw, dst, binary.LittleEndian)
+}
+
+// DecodeFloat32WithByteOrder converts FP32 data stored in order into a new
+// slice. Server responses are always little-endian; other orders are for
+// data from other sources.
+func DecodeFloat32WithByteOrder(raw []byte, order binary.ByteOrder) []float32 {
+	dst := make([]float32, len(raw)/4)
+	decodeFloat32Into(raw, dst, order)
+	return dst
+}
+
+func decodeFloat32Into(raw []byte, dst []float32, order binary.ByteOrder) error {
+	n := len(raw) / 4
+	if len(dst) < n {
+		return fmt.Errorf("destination holds %d elements, need %d", len(dst), n)
+	}
+	for i := 0; i < n; i++ {
+		dst[i] = math.Float32frombits(order.Uint32(raw[i*4:]))
+	}
+	return nil
+}
+
+// DecodeBool converts raw BOOL tensor contents, one byte per element, into
+// count booleans. Any nonzero byte is true. It is an error for raw to hold
+// other than count elements.
+func DecodeBool(raw []byte, count int) ([]bool, error) {
+	if len(raw) != count {
+		return nil, fmt.Errorf("BOOL contents hold %d elements, want %d", len(raw), count)
+	}
+	dst := make([]bool, count)
+	for i, b := range raw {
+		dst[i] = b != 0
+	}
+	return dst, nil
+}
+
+// DecodeFloat16 converts raw little-endian FP16 tensor contents into a new
+// slice of float32, which represents every half-precision value exactly.
+func DecodeFloat16(raw []byte) []float32 {
+	dst := make([]float32, len(raw)/2)
+	for i := range dst {
+		dst[i] = float16ToFloat32(binary.LittleEndian.Uint16(raw[i*2:]))
+	}
+	return dst
+}
+
+// DecodeBFloat16 converts raw little-endian BF16 tensor contents into a
+// new slice of float32. A bfloat16 is the top half of a float32, so every
+// value is represented exactly.
+func DecodeBFloat16(raw []byte) []float32 {
+	dst := make([]float32, len(raw)/2)
+	for i := range dst {
+		dst[i] = math.Float32frombits(uint32(binary.LittleEndian.Uint16(raw[i*2:])) << 16)
+	}
+	return dst
+}
+
+// float16ToFloat32 widens an IEEE 754 half-precision value, including
+// subnormals, infinities and NaN.
+func float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		// Zero or subnormal: mant * 2^-24.
+		f := float32(mant) / (1 << 24)
+		return math.Float32frombits(sign | math.Float32bits(f))
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+// DecodeInt64 converts raw little-endian INT64 tensor contents into a new
+// slice.
+func DecodeInt64(raw []byte) []int64 {
+	dst := make([]int64, len(raw)/8)
+	for i := range dst {
+		dst[i] = int64(binary.LittleEndian.Uint64(raw[i*8:]))
+	}
+	return dst
+}
+
+// decodeFixed converts raw little-endian contents of the fixed-size
+// datatypes without an exported decoder into a new slice of the matching
+// Go type: []int8, []int16, []uint8, []uint16, []uint32, []uint64 or
+// []float64. ok is false for any other datatype.
+func decodeFixed(datatype string, raw []byte) (decoded interface{}, ok bool) {
+	switch datatype {
+	case "INT8":
+		dst := make([]int8, len(raw))
+		for i, b := range raw {
+			dst[i] = int8(b)
+		}
+		return dst, true
+	case "INT16":
+		dst := make([]int16, len(raw)/2)
+		for i := range dst {
+			dst[i] = int16(binary.LittleEndian.Uint16(raw[i*2:]))
+		}
+		return dst, true
+	case "UINT8":
+		return append([]uint8(nil), raw...), true
+	case "UINT16":
+		dst := make([]uint16, len(raw)/2)
+		for i := range dst {
+			dst[i] = binary.LittleEndian.Uint16(raw[i*2:])
+		}
+		return dst, true
+	case "UINT32":
+		dst := make([]uint32, len(raw)/4)
+		for i := range dst {
+			dst[i] = binary.LittleEndian.Uint32(raw[i*4:])
+		}
+		return dst, true
+	case "UINT64":
+		dst := make([]uint64, len(raw)/8)
+		for i := range dst {
+			dst[i] = binary.LittleEndian.Uint64(raw[i*8:])
+		}
+		return dst, true
+	case "FP64":
+		dst := make([]float64, len(raw)/8)
+		for i := range dst {
+			dst[i] = math.Float64frombits(binary.LittleEndian.Uint64(raw[i*8:]))
+		}
+		return dst, true
+	}
+	return nil, false
+}
+
+// DecodeBytes parses raw BYTES tensor contents, a sequence of elements
+// each framed by its 4-byte little-endian length, into strings.
+func DecodeBytes(raw []byte) ([]string, error) {
+	return DecodeBytesWithByteOrder(raw, binary.LittleEndian)
+}
+
+// DecodeBytesOutput parses the raw contents of a BYTES output expected to
+// hold count elements, such as one label string per batch entry. Empty
+// elements decode as "" and the bytes of each element are returned as-is,
+// so UTF-8 survives the round trip. It is an error for raw to hold a
+// different number of elements.
+func DecodeBytesOutput(raw []byte, count int) ([]string, error) {
+	values, err := DecodeBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != count {
+		return nil, fmt.Errorf("BYTES contents hold %d elements, want %d", len(values), count)
+	}
+	return values, nil
+}
+
+// DecodeBytesWithByteOrder is DecodeBytes for length prefixes stored in
+// order, as written by PreprocessWithByteOrder.
+func DecodeBytesWithByteOrder(raw []byte, order binary.ByteOrder) ([]string, error) {
+	var values []string
+	for len(raw) > 0 {
+		if len(raw) < 4 {
+			return nil, errors.New("truncated BYTES length prefix")
+		}
+		n := order.Uint32(raw)
+		raw = raw[4:]
+		if uint32(len(raw)) < n {
+			return nil, fmt.Errorf("BYTES element of length %d overruns the remaining %d bytes", n, len(raw))
+		}
+		values = append(values, string(raw[:n]))
+		raw = raw[n:]
+	}
+	return values, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func float32Bytes(values []float32) []byte {
+	raw := make([]byte, 4*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint32(raw[i*4:], math.Float32bits(v))
+	}
+	return raw
+}
+
+func TestDecodeFloat32Into(t *testing.T) {
+	raw := float32Bytes([]float32{1.5, -2, 0})
+
+	dst := make([]float32, 4)
+	if err := DecodeFloat32Into(raw, dst); err != nil {
+		t.Fatalf("DecodeFloat32Into: %v", err)
+	}
+	want := []float32{1.5, -2, 0}
+	for i, v := range want {
+		if dst[i] != v {
+			t.Errorf("dst[%d] = %v, want %v", i, dst[i], v)
+		}
+	}
+
+	if err := DecodeFloat32Into(raw, make([]float32, 2)); err == nil {
+		t.Error("DecodeFloat32Into with a short destination succeeded")
+	}
+}
+
+func TestDecodeInt32Into(t *testing.T) {
+	raw := make([]byte, 8)
+	binary.LittleEndian.PutUint32(raw, uint32(math.MaxInt32))
+	minInt32 := int32(math.MinInt32)
+	binary.LittleEndian.PutUint32(raw[4:], uint32(minInt32))
+
+	dst := make([]int32, 2)
+	if err := DecodeInt32Into(raw, dst); err != nil {
+		t.Fatalf("DecodeInt32Into: %v", err)
+	}
+	if dst[0] != math.MaxInt32 || dst[1] != math.MinInt32 {
+		t.Errorf("got %v, want [%d %d]", dst, math.MaxInt32, math.MinInt32)
+	}
+}
+
+func BenchmarkDecodeFloat32(b *testing.B) {
+	raw := float32Bytes(make([]float32, 4096))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DecodeFloat32(raw)
+	}
+}
+
+func BenchmarkDecodeFloat32Into(b *testing.B) {
+	raw := float32Bytes(make([]float32, 4096))
+	dst := make([]float32, 4096)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DecodeFloat32Into(raw, dst)
+	}
+}
+
+func TestDecodeWithByteOrder(t *testing.T) {
+	raw := []byte{0, 0, 0, 1, 0x3f, 0x80, 0, 0}
+	if got := DecodeInt32WithByteOrder(raw, binary.BigEndian); len(got) != 2 || got[0] != 1 {
+		t.Errorf("DecodeInt32WithByteOrder = %v", got)
+	}
+	if got := DecodeFloat32WithByteOrder(raw, binary.BigEndian); len(got) != 2 || got[1] != 1 {
+		t.Errorf("DecodeFloat32WithByteOrder = %v", got)
+	}
+}
+
+func TestDecodeInt32Boundaries(t *testing.T) {
+	tests := []struct {
+		raw  []byte
+		want int32
+	}{
+		{[]byte{0x00, 0x00, 0x00, 0x00}, 0},
+		{[]byte{0x01, 0x00, 0x00, 0x00}, 1},
+		{[]byte{0xff, 0xff, 0xff, 0xff}, -1},
+		{[]byte{0xfe, 0xff, 0xff, 0xff}, -2},
+		{[]byte{0xff, 0xff, 0xff, 0x7f}, math.MaxInt32},
+		{[]byte{0x00, 0x00, 0x00, 0x80}, math.MinInt32},
+		{[]byte{0x00, 0x01, 0x00, 0x00}, 256},
+	}
+	for _, tt := range tests {
+		if got := DecodeInt32(tt.raw); len(got) != 1 || got[0] != tt.want {
+			t.Errorf("DecodeInt32(% x) = %v, want %d", tt.raw, got, tt.want)
+		}
+		if got := DecodeInt32WithByteOrder(tt.raw, binary.LittleEndian); got[0] != tt.want {
+			t.Errorf("DecodeInt32WithByteOrder(% x, LittleEndian) = %d, want %d", tt.raw, got[0], tt.want)
+		}
+	}
+
+	values := []int32{math.MinInt32, -1, 0, 1, math.MaxInt32}
+	raw, err := EncodeTensor("INT32", values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := DecodeInt32(raw)
+	for i := range values {
+		if got[i] != values[i] {
+			t.Errorf("round trip element %d = %d, want %d", i, got[i], values[i])
+		}
+	}
+}
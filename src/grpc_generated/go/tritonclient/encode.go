@@ -0,0 +1,199 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// datatypeSize returns the size in bytes of one element of a fixed-size
+// datatype. BYTES elements are variable-length and report false.
+func datatypeSize(datatype string) (int, bool) {
+	switch datatype {
+	case "BOOL", "INT8", "UINT8":
+		return 1, true
+	case "INT16", "UINT16", "FP16", "BF16":
+		return 2, true
+	case "INT32", "UINT32", "FP32":
+		return 4, true
+	case "INT64", "UINT64", "FP64":
+		return 8, true
+	}
+	return 0, false
+}
+
+// EncodeTensor converts data into raw little-endian tensor contents for
+// datatype. data is a slice of the Go type matching datatype:
+//
+//	BOOL   []bool
+//	INT8   []int8
+//	INT16  []int16
+//	INT32  []int32
+//	INT64  []int64
+//	UINT8  []uint8
+//	FP16   []float32, rounded to half precision
+//	FP32   []float32
+//	BYTES  []string
+//
+// A []byte is taken to be already encoded and passed through for any
+// datatype the server knows. Unknown datatypes and mismatched data are
+// rejected.
+func EncodeTensor(datatype string, data interface{}) ([]byte, error) {
+	size, fixed := datatypeSize(datatype)
+	if !fixed && datatype != "BYTES" {
+		return nil, fmt.Errorf("unsupported datatype %s", datatype)
+	}
+	if raw, ok := data.([]byte); ok {
+		if fixed && len(raw)%size != 0 {
+			return nil, fmt.Errorf("%d bytes is not a whole number of %d-byte %s elements", len(raw), size, datatype)
+		}
+		return raw, nil
+	}
+
+	switch datatype {
+	case "BYTES":
+		if values, ok := data.([]string); ok {
+			var raw []byte
+			for _, v := range values {
+				raw = appendBytesElement(raw, v, binary.LittleEndian)
+			}
+			return raw, nil
+		}
+	case "BOOL":
+		if values, ok := data.([]bool); ok {
+			return EncodeBool(values), nil
+		}
+	case "INT8":
+		if values, ok := data.([]int8); ok {
+			raw := make([]byte, len(values))
+			for i, v := range values {
+				raw[i] = byte(v)
+			}
+			return raw, nil
+		}
+	case "INT16":
+		if values, ok := data.([]int16); ok {
+			raw := make([]byte, 2*len(values))
+			for i, v := range values {
+				binary.LittleEndian.PutUint16(raw[i*2:], uint16(v))
+			}
+			return raw, nil
+		}
+	case "INT32":
+		if values, ok := data.([]int32); ok {
+			raw := make([]byte, 4*len(values))
+			for i, v := range values {
+				binary.LittleEndian.PutUint32(raw[i*4:], uint32(v))
+			}
+			return raw, nil
+		}
+	case "INT64":
+		if values, ok := data.([]int64); ok {
+			raw := make([]byte, 8*len(values))
+			for i, v := range values {
+				binary.LittleEndian.PutUint64(raw[i*8:], uint64(v))
+			}
+			return raw, nil
+		}
+	case "FP16":
+		if values, ok := data.([]float32); ok {
+			return EncodeFloat16(values), nil
+		}
+	case "FP32":
+		if values, ok := data.([]float32); ok {
+			return PreprocessFloat32(values), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot encode %T as %s", data, datatype)
+}
+
+// EncodeBool converts values to raw BOOL tensor contents, one byte per
+// element holding 0 or 1.
+func EncodeBool(values []bool) []byte {
+	raw := make([]byte, len(values))
+	for i, v := range values {
+		if v {
+			raw[i] = 1
+		}
+	}
+	return raw
+}
+
+// EncodeFloat16 converts values to little-endian IEEE 754 half precision
+// for an FP16 input. Each value is rounded to the nearest representable
+// half; values beyond the half range become infinity, NaN stays NaN.
+func EncodeFloat16(values []float32) []byte {
+	raw := make([]byte, 2*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint16(raw[i*2:], float16Bits(v))
+	}
+	return raw
+}
+
+// float16Bits converts f to IEEE 754 half precision, rounding to nearest
+// even. Values too large for half precision become infinity.
+func float16Bits(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int(bits>>23&0xff) - 127 + 15
+	mant := bits & 0x7fffff
+
+	switch {
+	case bits>>23&0xff == 0xff:
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	case exp >= 0x1f:
+		return sign | 0x7c00
+	case exp <= 0:
+		// Subnormal in half precision, or too small and rounds to zero.
+		if exp < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint(14 - exp)
+		half := uint16(mant >> shift)
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return sign | half
+	}
+
+	half := sign | uint16(exp)<<10 | uint16(mant>>13)
+	// Rounding up may carry into the exponent, which correctly yields the
+	// next power of two or infinity.
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		half++
+	}
+	return half
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeTensor(t *testing.T) {
+	tests := []struct {
+		datatype string
+		data     interface{}
+		want     []byte
+	}{
+		{"BOOL", []bool{true, false}, []byte{1, 0}},
+		{"INT8", []int8{-1, 2}, []byte{0xff, 2}},
+		{"INT16", []int16{-2}, []byte{0xfe, 0xff}},
+		{"INT32", []int32{1}, []byte{1, 0, 0, 0}},
+		{"INT64", []int64{-1}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"UINT8", []uint8{7, 8}, []byte{7, 8}},
+		{"FP16", []float32{1, -2}, []byte{0x00, 0x3c, 0x00, 0xc0}},
+		{"FP32", []float32{1}, []byte{0, 0, 0x80, 0x3f}},
+		{"BYTES", []string{"hi"}, []byte{2, 0, 0, 0, 'h', 'i'}},
+		{"FP64", []byte{1, 2, 3, 4, 5, 6, 7, 8}, []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+	}
+	for _, tt := range tests {
+		got, err := EncodeTensor(tt.datatype, tt.data)
+		if err != nil {
+			t.Errorf("EncodeTensor(%s): %v", tt.datatype, err)
+			continue
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("EncodeTensor(%s) = %v, want %v", tt.datatype, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeTensorErrors(t *testing.T) {
+	tests := []struct {
+		datatype string
+		data     interface{}
+	}{
+		{"COMPLEX64", []byte{1, 2}},
+		{"INT16", []int32{1}},
+		{"INT32", []byte{1, 2, 3}},
+		{"FP32", []float64{1}},
+	}
+	for _, tt := range tests {
+		if _, err := EncodeTensor(tt.datatype, tt.data); err == nil {
+			t.Errorf("EncodeTensor(%s, %T) succeeded", tt.datatype, tt.data)
+		}
+	}
+}
+
+func TestBoolRoundTrip(t *testing.T) {
+	mask := []bool{true, false, false, true}
+	raw := EncodeBool(mask)
+	if want := []byte{1, 0, 0, 1}; !bytes.Equal(raw, want) {
+		t.Fatalf("EncodeBool = %v, want %v", raw, want)
+	}
+	got, err := DecodeBool(raw, len(mask))
+	if err != nil {
+		t.Fatalf("DecodeBool: %v", err)
+	}
+	if !reflect.DeepEqual(got, mask) {
+		t.Errorf("DecodeBool = %v, want %v", got, mask)
+	}
+	if got, _ := DecodeBool([]byte{2}, 1); !got[0] {
+		t.Error("DecodeBool treated a nonzero byte as false")
+	}
+	if _, err := DecodeBool(raw, 3); err == nil {
+		t.Error("DecodeBool accepted a count that disagrees with the contents")
+	}
+}
+
+func TestFloat16Bits(t *testing.T) {
+	tests := []struct {
+		in   float32
+		want uint16
+	}{
+		{0, 0x0000},
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.1, 0x2e66},
+		{65504, 0x7bff},
+		{1e6, 0x7c00},
+		{float32(math.Pow(2, -24)), 0x0001},
+		{float32(math.Pow(2, -26)), 0x0000},
+		{float32(math.Inf(-1)), 0xfc00},
+	}
+	for _, tt := range tests {
+		if got := float16Bits(tt.in); got != tt.want {
+			t.Errorf("float16Bits(%v) = %#04x, want %#04x", tt.in, got, tt.want)
+		}
+	}
+	if got := float16Bits(float32(math.NaN())); got&0x7c00 != 0x7c00 || got&0x3ff == 0 {
+		t.Errorf("float16Bits(NaN) = %#04x, not a NaN", got)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+)
+
+// startCountingServer serves Live on a loopback port, counting the calls
+// it answers.
+func startCountingServer(t *testing.T, calls *int32) (addr string, stop func()) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := grpc.NewServer()
+	triton.RegisterGRPCInferenceServiceServer(s, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			atomic.AddInt32(calls, 1)
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis.Addr().String(), s.Stop
+}
+
+func TestFailover(t *testing.T) {
+	var firstCalls, secondCalls int32
+	first, stopFirst := startCountingServer(t, &firstCalls)
+	second, _ := startCountingServer(t, &secondCalls)
+
+	// Spaces and empty entries in the list are ignored.
+	client, err := NewTritonClient(" " + first + ", ," + second + ",")
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.Live(context.Background()); err != nil {
+		t.Fatalf("Live: %v", err)
+	}
+	if atomic.LoadInt32(&firstCalls) != 1 || atomic.LoadInt32(&secondCalls) != 0 {
+		t.Fatalf("calls = %d, %d; want the first endpoint used", firstCalls, secondCalls)
+	}
+
+	stopFirst()
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&secondCalls) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("calls never moved to the second endpoint")
+		}
+		client.Live(context.Background())
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := client.Live(context.Background()); err != nil {
+		t.Errorf("Live after failover: %v", err)
+	}
+	if n := atomic.LoadInt32(&firstCalls); n != 1 {
+		t.Errorf("stopped endpoint answered %d calls, want 1", n)
+	}
+}
+
+func TestFailoverTarget(t *testing.T) {
+	target, _, err := failoverTarget(strings.Split(" a:1 ,,b:2", ","))
+	if err != nil {
+		t.Fatalf("failoverTarget: %v", err)
+	}
+	if want := failoverScheme + ":///a:1"; target != want {
+		t.Errorf("target = %q, want %q", target, want)
+	}
+	if _, err := NewTritonClient(" , "); err == nil {
+		t.Error("NewTritonClient accepted a list with no endpoints")
+	}
+}
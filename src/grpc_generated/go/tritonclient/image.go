@@ -0,0 +1,83 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	triton "nvidia_inferenceserver"
+)
+
+// ImageHWC flattens img into UINT8 tensor data in height, width, channel
+// order with three RGB channels, the layout most vision models take. It
+// returns the data and its shape [height width 3]; prepend a batch
+// dimension to the shape if the model expects one. Alpha is dropped
+// after un-premultiplying.
+func ImageHWC(img image.Image) ([]uint8, []int64) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	data := make([]uint8, 0, width*height*3)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			data = append(data, c.R, c.G, c.B)
+		}
+	}
+	return data, []int64{int64(height), int64(width), 3}
+}
+
+// NewImageInput describes a UINT8 input tensor holding img in the layout
+// of ImageHWC, with shape [height width 3]. Like NewInput it returns the
+// tensor and its contents for the request's Inputs and RawInputContents.
+func NewImageInput(name string, img image.Image) (*triton.ModelInferRequest_InferInputTensor, []byte, error) {
+	data, shape := ImageHWC(img)
+	return NewInput(name, shape, data)
+}
+
+// ImageFromHWC builds an image from UINT8 data in the layout of ImageHWC,
+// such as an output decoded with PostprocessUint8. shape must be
+// [height width 3], optionally preceded by a batch dimension of 1.
+func ImageFromHWC(data []uint8, shape []int64) (*image.RGBA, error) {
+	if len(shape) == 4 && shape[0] == 1 {
+		shape = shape[1:]
+	}
+	if len(shape) != 3 || shape[2] != 3 || shape[0] < 0 || shape[1] < 0 {
+		return nil, fmt.Errorf("shape %v is not [height width 3]", shape)
+	}
+	height, width := int(shape[0]), int(shape[1])
+	if len(data) != height*width*3 {
+		return nil, fmt.Errorf("%d bytes do not fill shape %v", len(data), shape)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := 0; i < height*width; i++ {
+		copy(img.Pix[i*4:], data[i*3:i*3+3])
+		img.Pix[i*4+3] = 0xff
+	}
+	return img, nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestImageHWC(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.NRGBA{R: 1, G: 2, B: 3, A: 0xff})
+	img.Set(1, 0, color.NRGBA{R: 4, G: 5, B: 6, A: 0xff})
+
+	tensor, raw, err := NewImageInput("IMAGE", img)
+	if err != nil {
+		t.Fatalf("NewImageInput: %v", err)
+	}
+	if tensor.Datatype != "UINT8" || !reflect.DeepEqual(tensor.Shape, []int64{1, 2, 3}) {
+		t.Errorf("tensor = %v %v, want UINT8 [1 2 3]", tensor.Datatype, tensor.Shape)
+	}
+	if want := []byte{1, 2, 3, 4, 5, 6}; !bytes.Equal(raw, want) {
+		t.Errorf("contents = %v, want %v", raw, want)
+	}
+
+	resp := &triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "IMAGE", Datatype: "UINT8", Shape: []int64{1, 1, 2, 3}},
+		},
+		RawOutputContents: [][]byte{raw},
+	}
+	data, err := PostprocessUint8(resp, 0)
+	if err != nil {
+		t.Fatalf("PostprocessUint8: %v", err)
+	}
+	out, err := ImageFromHWC(data, resp.Outputs[0].Shape)
+	if err != nil {
+		t.Fatalf("ImageFromHWC: %v", err)
+	}
+	if got := out.At(1, 0); got != (color.RGBA{R: 4, G: 5, B: 6, A: 0xff}) {
+		t.Errorf("pixel (1, 0) = %v", got)
+	}
+
+	if _, err := ImageFromHWC(data, []int64{2, 1, 4}); err == nil {
+		t.Error("ImageFromHWC accepted four channels")
+	}
+	if _, err := ImageFromHWC(data[:5], []int64{1, 2, 3}); err == nil {
+		t.Error("ImageFromHWC accepted data shorter than the shape")
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+// Infer submits req and returns the server's response.
+func (c *TritonClient) Infer(ctx context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+	resp, _, err := c.InferWithLatency(ctx, req)
+	return resp, err
+}
+
+// InferWithLatency submits req and also returns the round-trip latency of
+// the ModelInfer RPC. Time spent queued behind WithMaxConcurrent is not
+// included.
+func (c *TritonClient) InferWithLatency(ctx context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, time.Duration, error) {
+	if err := ValidateRawInputContents(req); err != nil {
+		return nil, 0, err
+	}
+	version, err := normalizeVersion(req.ModelVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version != req.ModelVersion {
+		req = withModelVersion(req, version)
+	}
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	if c.validateBatchSize {
+		if err := c.checkBatchSize(ctx, req); err != nil {
+			return nil, 0, err
+		}
+	}
+	if c.validateInputs {
+		metadata, err := c.ModelMetadata(ctx, req.ModelName, req.ModelVersion)
+		if err != nil {
+			return nil, 0, fmt.Errorf("couldn't get metadata to check inputs: %w", err)
+		}
+		if err := ValidateInputs(req, metadata); err != nil {
+			return nil, 0, err
+		}
+	}
+	if err := c.limit.acquire(ctx); err != nil {
+		return nil, 0, err
+	}
+	defer c.limit.release()
+
+	start := time.Now()
+	resp, err := c.client.ModelInfer(ctx, req)
+	latency := time.Since(start)
+	c.metrics.ObserveInfer(req.ModelName, latency, err)
+	return resp, latency, err
+}
+
+// withModelVersion returns a shallow copy of req addressing version, so
+// the caller's request is left as it was.
+func withModelVersion(req *triton.ModelInferRequest, version string) *triton.ModelInferRequest {
+	return &triton.ModelInferRequest{
+		ModelName:        req.ModelName,
+		ModelVersion:     version,
+		Id:               req.Id,
+		Parameters:       req.Parameters,
+		Inputs:           req.Inputs,
+		Outputs:          req.Outputs,
+		RawInputContents: req.RawInputContents,
+	}
+}
+
+// WithBatchSizeValidation checks the batch size of every inference
+// against the model's max_batch_size before sending it, so oversized
+// batches fail with a clear error instead of a server rejection. Model
+// configs are fetched once and cached, as with WithMetadataCache.
+func WithBatchSizeValidation() Option {
+	return func(o *options) {
+		o.validateBatchSize = true
+	}
+}
+
+// WithInputValidation checks the inputs of every inference against the
+// model's metadata with ValidateInputs before sending it. Metadata is
+// fetched once per model and cached, as with WithMetadataCache.
+func WithInputValidation() Option {
+	return func(o *options) {
+		o.validateInputs = true
+	}
+}
+
+// checkBatchSize rejects req if the first dimension of an input exceeds
+// the model's max_batch_size. Models that don't batch are not checked.
+func (c *TritonClient) checkBatchSize(ctx context.Context, req *triton.ModelInferRequest) error {
+	resp, err := c.ModelConfig(ctx, req.ModelName, req.ModelVersion)
+	if err != nil {
+		return fmt.Errorf("couldn't get config to check batch size: %w", err)
+	}
+	maxBatchSize := int64(resp.GetConfig().GetMaxBatchSize())
+	if maxBatchSize <= 0 {
+		return nil
+	}
+	for _, input := range req.Inputs {
+		if len(input.Shape) > 0 && input.Shape[0] > maxBatchSize {
+			return fmt.Errorf("batch size %d exceeds model max_batch_size %d", input.Shape[0], maxBatchSize)
+		}
+	}
+	return nil
+}
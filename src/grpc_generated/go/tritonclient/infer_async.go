@@ -0,0 +1,63 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+
+	triton "nvidia_inferenceserver"
+)
+
+// InferCall is an inference started by InferAsync.
+type InferCall struct {
+	done chan struct{}
+	resp *triton.ModelInferResponse
+	err  error
+}
+
+// InferAsync starts an inference and returns without waiting for it, so
+// the caller can overlap other work, such as preprocessing the next
+// request, with the call in flight. Canceling ctx abandons the call.
+func (c *TritonClient) InferAsync(ctx context.Context, req *triton.ModelInferRequest) *InferCall {
+	call := &InferCall{done: make(chan struct{})}
+	go func() {
+		defer close(call.done)
+		call.resp, call.err = c.Infer(ctx, req)
+	}()
+	return call
+}
+
+// Done returns a channel that is closed when the call completes.
+func (call *InferCall) Done() <-chan struct{} {
+	return call.done
+}
+
+// Result waits for the call to complete and returns its outcome.
+func (call *InferCall) Result() (*triton.ModelInferResponse, error) {
+	<-call.done
+	return call.resp, call.err
+}
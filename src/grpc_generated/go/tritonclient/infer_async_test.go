@@ -0,0 +1,82 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestInferAsync(t *testing.T) {
+	release := make(chan struct{})
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(ctx context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			select {
+			case <-release:
+				return &triton.ModelInferResponse{Id: req.Id}, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		},
+	})
+
+	call := client.InferAsync(context.Background(), &triton.ModelInferRequest{Id: "n"})
+	select {
+	case <-call.Done():
+		t.Fatal("call completed before the server responded")
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(release)
+	resp, err := call.Result()
+	if err != nil {
+		t.Fatalf("Result: %v", err)
+	}
+	if resp.Id != "n" {
+		t.Errorf("response id = %q, want n", resp.Id)
+	}
+	<-call.Done()
+}
+
+func TestInferAsyncCanceled(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(ctx context.Context, _ *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	call := client.InferAsync(ctx, &triton.ModelInferRequest{})
+	cancel()
+	if _, err := call.Result(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Result error = %v, want context.Canceled", err)
+	}
+}
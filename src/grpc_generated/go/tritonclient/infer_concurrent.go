@@ -0,0 +1,75 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"sync"
+
+	triton "nvidia_inferenceserver"
+)
+
+// InferBatchConcurrent sends independent inference requests over the
+// client's connection with at most concurrency in flight at once. The
+// responses and errors are index-aligned with reqs: for each i exactly one
+// of resps[i] and errs[i] is set. Once ctx is done no further requests are
+// dispatched, and those left over fail with the context's error.
+func (c *TritonClient) InferBatchConcurrent(ctx context.Context, reqs []*triton.ModelInferRequest, concurrency int) ([]*triton.ModelInferResponse, []error) {
+	if concurrency <= 0 || concurrency > len(reqs) {
+		concurrency = len(reqs)
+	}
+	resps := make([]*triton.ModelInferResponse, len(reqs))
+	errs := make([]error, len(reqs))
+
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				resps[i], errs[i] = c.Infer(ctx, reqs[i])
+			}
+		}()
+	}
+
+	i := 0
+dispatch:
+	for ; i < len(reqs); i++ {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(next)
+	for ; i < len(reqs); i++ {
+		errs[i] = ctx.Err()
+	}
+	wg.Wait()
+	return resps, errs
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestInferBatchConcurrent(t *testing.T) {
+	var inFlight, peak int32
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(_ context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			if req.Id == "bad" {
+				return nil, fmt.Errorf("rejected")
+			}
+			return &triton.ModelInferResponse{Id: req.Id}, nil
+		},
+	})
+
+	var reqs []*triton.ModelInferRequest
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprint(i)
+		if i == 7 {
+			id = "bad"
+		}
+		reqs = append(reqs, &triton.ModelInferRequest{Id: id})
+	}
+	resps, errs := client.InferBatchConcurrent(context.Background(), reqs, 4)
+
+	for i := range reqs {
+		if i == 7 {
+			if errs[i] == nil || resps[i] != nil {
+				t.Errorf("request 7: resp %v, err %v, want an error", resps[i], errs[i])
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Errorf("request %d: %v", i, errs[i])
+		} else if resps[i].Id != reqs[i].Id {
+			t.Errorf("response %d has id %s, want %s", i, resps[i].Id, reqs[i].Id)
+		}
+	}
+	if peak > 4 {
+		t.Errorf("%d requests in flight, want at most 4", peak)
+	}
+}
+
+func TestInferBatchConcurrentCanceled(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			return &triton.ModelInferResponse{}, nil
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reqs := make([]*triton.ModelInferRequest, 5)
+	for i := range reqs {
+		reqs[i] = &triton.ModelInferRequest{}
+	}
+	resps, errs := client.InferBatchConcurrent(ctx, reqs, 2)
+	for i := range reqs {
+		if errs[i] == nil || resps[i] != nil {
+			t.Errorf("request %d: resp %v, err %v, want an error", i, resps[i], errs[i])
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// InferMap runs an inference using plain Go slices. inputs maps every
+// input of the model to a []float32, []int32, []int64 or []string; the
+// datatypes and shapes come from the model metadata, with a single dynamic
+// dimension sized to fit the data. All outputs are returned, decoded as
+// InferResult.Output decodes them.
+func (c *TritonClient) InferMap(ctx context.Context, model, version string, inputs map[string]interface{}) (map[string]interface{}, error) {
+	metadata, err := c.ModelMetadata(ctx, model, version)
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) != len(metadata.Inputs) {
+		return nil, fmt.Errorf("model %s takes %d inputs, got %d", model, len(metadata.Inputs), len(inputs))
+	}
+
+	builder := NewRequestBuilder(model, version)
+	for _, input := range metadata.Inputs {
+		data, ok := inputs[input.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing input %s", input.Name)
+		}
+		count, err := elementCount(data)
+		if err != nil {
+			return nil, fmt.Errorf("input %s: %w", input.Name, err)
+		}
+		shape, err := resolveShape(input.Shape, count)
+		if err != nil {
+			return nil, fmt.Errorf("input %s: %w", input.Name, err)
+		}
+		builder.WithInput(input.Name, input.Datatype, shape, data)
+	}
+	names := make([]string, len(metadata.Outputs))
+	for i, output := range metadata.Outputs {
+		builder.WithOutput(output.Name)
+		names[i] = output.Name
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, _, err := c.InferWithLatency(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return NewInferResult(resp).Outputs(names...)
+}
+
+func elementCount(data interface{}) (int, error) {
+	switch values := data.(type) {
+	case []float32:
+		return len(values), nil
+	case []int32:
+		return len(values), nil
+	case []int64:
+		return len(values), nil
+	case []string:
+		return len(values), nil
+	}
+	return 0, fmt.Errorf("unsupported input type %T", data)
+}
+
+// resolveShape fills in the dynamic (-1) dimension of shape so that it
+// holds count elements. At most one dimension may be dynamic.
+func resolveShape(shape []int64, count int) ([]int64, error) {
+	resolved := make([]int64, len(shape))
+	dynamic := -1
+	fixed := int64(1)
+	for i, dim := range shape {
+		resolved[i] = dim
+		if dim != -1 {
+			fixed *= dim
+			continue
+		}
+		if dynamic >= 0 {
+			return nil, fmt.Errorf("shape %v has more than one dynamic dimension", shape)
+		}
+		dynamic = i
+	}
+	if dynamic >= 0 {
+		if fixed == 0 || int64(count)%fixed != 0 {
+			return nil, fmt.Errorf("%d elements don't fit shape %v", count, shape)
+		}
+		resolved[dynamic] = int64(count) / fixed
+	} else if fixed != int64(count) {
+		return nil, fmt.Errorf("%d elements don't fit shape %v", count, shape)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestInferMap(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		modelMetadata: func(context.Context, *triton.ModelMetadataRequest) (*triton.ModelMetadataResponse, error) {
+			return &triton.ModelMetadataResponse{
+				Name: "scale",
+				Inputs: []*triton.ModelMetadataResponse_TensorMetadata{
+					{Name: "INPUT0", Datatype: "FP32", Shape: []int64{-1, 2}},
+				},
+				Outputs: []*triton.ModelMetadataResponse_TensorMetadata{
+					{Name: "OUTPUT0", Datatype: "FP32", Shape: []int64{-1, 2}},
+				},
+			}, nil
+		},
+		modelInfer: func(_ context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			if shape := req.Inputs[0].Shape; !reflect.DeepEqual(shape, []int64{2, 2}) {
+				t.Errorf("INPUT0 shape = %v, want [2 2]", shape)
+			}
+			doubled := DecodeFloat32(req.RawInputContents[0])
+			for i := range doubled {
+				doubled[i] *= 2
+			}
+			return &triton.ModelInferResponse{
+				Outputs:           []*triton.ModelInferResponse_InferOutputTensor{{Name: "OUTPUT0", Datatype: "FP32", Shape: req.Inputs[0].Shape}},
+				RawOutputContents: [][]byte{float32Bytes(doubled)},
+			}, nil
+		},
+	})
+
+	outputs, err := client.InferMap(context.Background(), "scale", "", map[string]interface{}{
+		"INPUT0": []float32{1, 2, 3, 4},
+	})
+	if err != nil {
+		t.Fatalf("InferMap: %v", err)
+	}
+	if got, want := outputs["OUTPUT0"], []float32{2, 4, 6, 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OUTPUT0 = %v, want %v", got, want)
+	}
+
+	if _, err := client.InferMap(context.Background(), "scale", "", map[string]interface{}{"INPUT1": []float32{1, 2}}); err == nil {
+		t.Error("InferMap with the wrong input name succeeded")
+	}
+	if _, err := client.InferMap(context.Background(), "scale", "", map[string]interface{}{"INPUT0": []float32{1, 2, 3}}); err == nil {
+		t.Error("InferMap with a ragged input succeeded")
+	}
+}
+
+func TestResolveShape(t *testing.T) {
+	tests := []struct {
+		shape []int64
+		count int
+		want  []int64
+	}{
+		{[]int64{-1, 4}, 8, []int64{2, 4}},
+		{[]int64{3}, 3, []int64{3}},
+		{[]int64{2, -1}, 6, []int64{2, 3}},
+	}
+	for _, tt := range tests {
+		got, err := resolveShape(tt.shape, tt.count)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("resolveShape(%v, %d) = %v, %v; want %v", tt.shape, tt.count, got, err, tt.want)
+		}
+	}
+	if _, err := resolveShape([]int64{-1, -1}, 4); err == nil {
+		t.Error("resolveShape accepted two dynamic dimensions")
+	}
+	if _, err := resolveShape([]int64{4}, 3); err == nil {
+		t.Error("resolveShape accepted a count that doesn't match a static shape")
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchSizeValidation(t *testing.T) {
+	var configCalls, inferCalls int32
+	client := startFakeServer(t, &fakeServer{
+		modelConfig: func(context.Context, *triton.ModelConfigRequest) (*triton.ModelConfigResponse, error) {
+			atomic.AddInt32(&configCalls, 1)
+			return &triton.ModelConfigResponse{Config: &triton.ModelConfig{MaxBatchSize: 4}}, nil
+		},
+		modelInfer: func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			atomic.AddInt32(&inferCalls, 1)
+			return &triton.ModelInferResponse{}, nil
+		},
+	}, WithBatchSizeValidation())
+
+	build := func(batchSize int) *triton.ModelInferRequest {
+		req, err := NewRequestBuilder("simple", "").
+			WithInput("INPUT0", "INT32", []int64{int64(batchSize), 1}, make([]int32, batchSize)).
+			Build()
+		if err != nil {
+			t.Fatalf("Build: %v", err)
+		}
+		return req
+	}
+
+	if _, err := client.Infer(context.Background(), build(4)); err != nil {
+		t.Fatalf("Infer with batch size 4: %v", err)
+	}
+	_, err := client.Infer(context.Background(), build(8))
+	if err == nil || !strings.Contains(err.Error(), "batch size 8 exceeds model max_batch_size 4") {
+		t.Errorf("Infer with batch size 8: %v", err)
+	}
+	if got := atomic.LoadInt32(&inferCalls); got != 1 {
+		t.Errorf("server saw %d inferences, want 1", got)
+	}
+	if got := atomic.LoadInt32(&configCalls); got != 1 {
+		t.Errorf("server saw %d config calls, want 1", got)
+	}
+}
+
+func TestInputValidation(t *testing.T) {
+	var metadataCalls, inferCalls int32
+	client := startFakeServer(t, &fakeServer{
+		modelMetadata: func(context.Context, *triton.ModelMetadataRequest) (*triton.ModelMetadataResponse, error) {
+			atomic.AddInt32(&metadataCalls, 1)
+			return &triton.ModelMetadataResponse{
+				Name: "resnet",
+				Inputs: []*triton.ModelMetadataResponse_TensorMetadata{
+					{Name: "INPUT0", Datatype: "FP32", Shape: []int64{-1, 3, 2, 2}},
+				},
+			}, nil
+		},
+		modelInfer: func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			atomic.AddInt32(&inferCalls, 1)
+			return &triton.ModelInferResponse{}, nil
+		},
+	}, WithInputValidation())
+
+	build := func(shape ...int64) *triton.ModelInferRequest {
+		n := int64(1)
+		for _, dim := range shape {
+			n *= dim
+		}
+		req, err := NewRequestBuilder("resnet", "").
+			WithInput("INPUT0", "FP32", shape, make([]float32, n)).
+			Build()
+		if err != nil {
+			t.Fatalf("Build: %v", err)
+		}
+		return req
+	}
+
+	if _, err := client.Infer(context.Background(), build(2, 3, 2, 2)); err != nil {
+		t.Fatalf("Infer with a matching shape: %v", err)
+	}
+	_, err := client.Infer(context.Background(), build(1, 2, 2))
+	if err == nil || err.Error() != "INPUT0 expected [-1 3 2 2] got [1 2 2]" {
+		t.Errorf("Infer with the wrong rank: %v", err)
+	}
+	if _, err := client.Infer(context.Background(), build(1, 4, 2, 2)); err == nil {
+		t.Error("Infer with the wrong fixed dimension succeeded")
+	}
+	if got := atomic.LoadInt32(&inferCalls); got != 1 {
+		t.Errorf("server saw %d inferences, want 1", got)
+	}
+	if got := atomic.LoadInt32(&metadataCalls); got != 1 {
+		t.Errorf("server saw %d metadata calls, want 1", got)
+	}
+}
+
+// recordingMetrics keeps every observation made through it.
+type recordingMetrics struct {
+	mu     sync.Mutex
+	models []string
+	errs   []error
+}
+
+func (m *recordingMetrics) ObserveInfer(model string, dur time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.models = append(m.models, model)
+	m.errs = append(m.errs, err)
+}
+
+func TestInferMetrics(t *testing.T) {
+	metrics := &recordingMetrics{}
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(_ context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			if req.ModelName == "broken" {
+				return nil, status.Error(codes.Internal, "boom")
+			}
+			return &triton.ModelInferResponse{}, nil
+		},
+	}, WithMetrics(metrics))
+	ctx := context.Background()
+
+	client.Infer(ctx, &triton.ModelInferRequest{ModelName: "simple"})
+	client.Infer(ctx, &triton.ModelInferRequest{ModelName: "broken"})
+
+	if want := []string{"simple", "broken"}; !reflect.DeepEqual(metrics.models, want) {
+		t.Errorf("observed models %v, want %v", metrics.models, want)
+	}
+	if len(metrics.errs) == 2 && (metrics.errs[0] != nil || status.Code(metrics.errs[1]) != codes.Internal) {
+		t.Errorf("observed errors %v, want [nil Internal]", metrics.errs)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"fmt"
+
+	triton "nvidia_inferenceserver"
+)
+
+// NewInput describes an input tensor holding data, a slice whose Go type
+// determines the Triton datatype: []bool is BOOL, []int8 INT8, []int16
+// INT16, []int32 INT32, []int64 INT64, []uint8 UINT8, []float32 FP32 and
+// []string BYTES. The number of elements must match shape exactly. It
+// returns the tensor and its encoded contents, to be appended to a
+// request's Inputs and RawInputContents respectively.
+func NewInput(name string, shape []int64, data interface{}) (*triton.ModelInferRequest_InferInputTensor, []byte, error) {
+	datatype, count, err := inputDatatype(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("input %s: %w", name, err)
+	}
+	n := int64(1)
+	for _, dim := range shape {
+		if dim < 0 {
+			return nil, nil, fmt.Errorf("input %s has invalid shape %v", name, shape)
+		}
+		n *= dim
+	}
+	if n != int64(count) {
+		return nil, nil, fmt.Errorf("input %s has %d elements but shape %v holds %d", name, count, shape, n)
+	}
+	raw, err := EncodeTensor(datatype, data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("input %s: %w", name, err)
+	}
+	tensor := &triton.ModelInferRequest_InferInputTensor{
+		Name:     name,
+		Datatype: datatype,
+		Shape:    shape,
+	}
+	return tensor, raw, nil
+}
+
+// inputDatatype returns the Triton datatype for the Go type of data and
+// the number of elements it holds.
+func inputDatatype(data interface{}) (string, int, error) {
+	switch values := data.(type) {
+	case []bool:
+		return "BOOL", len(values), nil
+	case []int8:
+		return "INT8", len(values), nil
+	case []int16:
+		return "INT16", len(values), nil
+	case []int32:
+		return "INT32", len(values), nil
+	case []int64:
+		return "INT64", len(values), nil
+	case []uint8:
+		return "UINT8", len(values), nil
+	case []float32:
+		return "FP32", len(values), nil
+	case []string:
+		return "BYTES", len(values), nil
+	}
+	return "", 0, fmt.Errorf("no Triton datatype for %T", data)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestNewInput(t *testing.T) {
+	tests := []struct {
+		data     interface{}
+		datatype string
+	}{
+		{[]bool{true, false}, "BOOL"},
+		{[]int8{1, -1}, "INT8"},
+		{[]int16{1, -1}, "INT16"},
+		{[]int32{1, -1}, "INT32"},
+		{[]int64{1, -1}, "INT64"},
+		{[]uint8{1, 255}, "UINT8"},
+		{[]float32{1, -1}, "FP32"},
+		{[]string{"a", ""}, "BYTES"},
+	}
+	for _, tt := range tests {
+		tensor, raw, err := NewInput("INPUT0", []int64{1, 2}, tt.data)
+		if err != nil {
+			t.Errorf("NewInput(%T): %v", tt.data, err)
+			continue
+		}
+		if tensor.Name != "INPUT0" || tensor.Datatype != tt.datatype || !reflect.DeepEqual(tensor.Shape, []int64{1, 2}) {
+			t.Errorf("NewInput(%T) = %v, want INPUT0 %s [1 2]", tt.data, tensor, tt.datatype)
+		}
+		want, _ := EncodeTensor(tt.datatype, tt.data)
+		if !bytes.Equal(raw, want) {
+			t.Errorf("NewInput(%T) contents = %v, want %v", tt.data, raw, want)
+		}
+	}
+}
+
+func TestNewInputErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		shape []int64
+		data  interface{}
+	}{
+		{"too few elements", []int64{2, 2}, []int32{1, 2, 3}},
+		{"too many elements", []int64{2}, []float32{1, 2, 3}},
+		{"dynamic dimension", []int64{-1, 2}, []int32{1, 2}},
+		{"unsupported type", []int64{1}, []float64{1}},
+	}
+	for _, tt := range tests {
+		if _, _, err := NewInput("INPUT0", tt.shape, tt.data); err == nil {
+			t.Errorf("%s: NewInput succeeded, want error", tt.name)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+//go:build integration
+
+// Integration tests against a real Triton server, run in Docker with
+// testcontainers-go. They are built only with the integration tag:
+//
+//	go test -tags integration ./...
+//
+// and skip when Docker is unavailable. TRITON_IMAGE overrides the server
+// image.
+
+package tritonclient
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const defaultTritonImage = "nvcr.io/nvidia/tritonserver:24.08-py3"
+
+// simpleModelConfig and simpleModelPy define the "simple" model of the
+// examples as a Python backend model: OUTPUT0 is the element-wise sum of
+// its two INT32 inputs and OUTPUT1 their difference.
+const simpleModelConfig = `name: "simple"
+backend: "python"
+max_batch_size: 8
+input [
+  { name: "INPUT0" data_type: TYPE_INT32 dims: [ 16 ] },
+  { name: "INPUT1" data_type: TYPE_INT32 dims: [ 16 ] }
+]
+output [
+  { name: "OUTPUT0" data_type: TYPE_INT32 dims: [ 16 ] },
+  { name: "OUTPUT1" data_type: TYPE_INT32 dims: [ 16 ] }
+]
+`
+
+const simpleModelPy = `import numpy as np
+import triton_python_backend_utils as pb_utils
+
+
+class TritonPythonModel:
+    def execute(self, requests):
+        responses = []
+        for request in requests:
+            in0 = pb_utils.get_input_tensor_by_name(request, "INPUT0").as_numpy()
+            in1 = pb_utils.get_input_tensor_by_name(request, "INPUT1").as_numpy()
+            responses.append(pb_utils.InferenceResponse([
+                pb_utils.Tensor("OUTPUT0", (in0 + in1).astype(np.int32)),
+                pb_utils.Tensor("OUTPUT1", (in0 - in1).astype(np.int32)),
+            ]))
+        return responses
+`
+
+// startTriton runs a Triton server serving the simple model and returns a
+// client connected to its gRPC port.
+func startTriton(t *testing.T) *TritonClient {
+	t.Helper()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	files := map[string]string{"config.pbtxt": simpleModelConfig, "model.py": simpleModelPy}
+	var containerFiles []testcontainers.ContainerFile
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		target := "/models/simple/config.pbtxt"
+		if name == "model.py" {
+			target = "/models/simple/1/model.py"
+		}
+		containerFiles = append(containerFiles, testcontainers.ContainerFile{HostFilePath: path, ContainerFilePath: target, FileMode: 0644})
+	}
+
+	image := os.Getenv("TRITON_IMAGE")
+	if image == "" {
+		image = defaultTritonImage
+	}
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        image,
+			Cmd:          []string{"tritonserver", "--model-repository=/models"},
+			ExposedPorts: []string{"8001/tcp"},
+			Files:        containerFiles,
+			WaitingFor:   wait.ForLog("Started GRPCInferenceService").WithStartupTimeout(10 * time.Minute),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatalf("starting %s: %v", image, err)
+	}
+	t.Cleanup(func() { container.Terminate(ctx) })
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := container.MappedPort(ctx, "8001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewTritonClient(host + ":" + port.Port())
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err := client.WaitUntilReady(ctx, 100*time.Millisecond, time.Minute); err != nil {
+		t.Fatalf("server never became ready: %v", err)
+	}
+	return client
+}
+
+func TestIntegrationSimple(t *testing.T) {
+	client := startTriton(t)
+	ctx := context.Background()
+
+	if h := client.HealthCheck(ctx); !h.Healthy() {
+		t.Fatalf("HealthCheck = %+v", h)
+	}
+	metadata, err := client.ModelMetadata(ctx, "simple", "")
+	if err != nil {
+		t.Fatalf("ModelMetadata: %v", err)
+	}
+	if len(metadata.Inputs) != 2 || metadata.Inputs[0].Datatype != "INT32" || !reflect.DeepEqual(metadata.Inputs[0].Shape, []int64{-1, 16}) {
+		t.Errorf("ModelMetadata inputs = %v", metadata.Inputs)
+	}
+
+	in0 := make([]int32, 16)
+	in1 := make([]int32, 16)
+	sum := make([]int32, 16)
+	diff := make([]int32, 16)
+	for i := range in0 {
+		in0[i], in1[i] = int32(i), 1
+		sum[i], diff[i] = int32(i)+1, int32(i)-1
+	}
+	req, err := NewRequestBuilder("simple", "").
+		WithInput("INPUT0", "INT32", []int64{1, 16}, in0).
+		WithInput("INPUT1", "INT32", []int64{1, 16}, in1).
+		WithOutput("OUTPUT0").
+		WithOutput("OUTPUT1").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Infer(ctx, req)
+	if err != nil {
+		t.Fatalf("Infer: %v", err)
+	}
+	if err := ValidateOutputs(resp, metadata); err != nil {
+		t.Fatal(err)
+	}
+	outputs, err := NewInferResult(resp).Outputs("OUTPUT0", "OUTPUT1")
+	if err != nil {
+		t.Fatalf("Outputs: %v", err)
+	}
+	if !reflect.DeepEqual(outputs["OUTPUT0"], sum) {
+		t.Errorf("OUTPUT0 = %v, want %v", outputs["OUTPUT0"], sum)
+	}
+	if !reflect.DeepEqual(outputs["OUTPUT1"], diff) {
+		t.Errorf("OUTPUT1 = %v, want %v", outputs["OUTPUT1"], diff)
+	}
+}
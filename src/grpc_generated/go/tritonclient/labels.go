@@ -0,0 +1,121 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Classification is one entry of a top-k classification result.
+type Classification struct {
+	Index int
+	Label string
+	Score float32
+}
+
+// ReadLabels reads a Triton labels file, which holds one label per line
+// with line i naming class index i.
+func ReadLabels(r io.Reader) ([]string, error) {
+	var labels []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		labels = append(labels, strings.TrimRight(scanner.Text(), "\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// Classify returns the k highest scores, best first, each paired with its
+// label. labels may be nil, in which case only indices and scores are
+// filled in; otherwise it must name every class in scores. Ties keep
+// index order.
+func Classify(scores []float32, labels []string, k int) ([]Classification, error) {
+	if k <= 0 {
+		return nil, fmt.Errorf("invalid top-k %d", k)
+	}
+	if labels != nil && len(labels) < len(scores) {
+		return nil, fmt.Errorf("got %d labels for %d classes", len(labels), len(scores))
+	}
+
+	indices := make([]int, len(scores))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(a, b int) bool {
+		return scores[indices[a]] > scores[indices[b]]
+	})
+	if k > len(indices) {
+		k = len(indices)
+	}
+
+	results := make([]Classification, k)
+	for i, index := range indices[:k] {
+		results[i] = Classification{Index: index, Score: scores[index]}
+		if labels != nil {
+			results[i].Label = labels[index]
+		}
+	}
+	return results, nil
+}
+
+// DecodeClassifications parses the raw contents of an output requested
+// with WithClassificationOutput. Each element is "score:index:label", or
+// "score:index" when the model has no labels file.
+func DecodeClassifications(raw []byte) ([]Classification, error) {
+	values, err := DecodeBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	classes := make([]Classification, len(values))
+	for i, v := range values {
+		// The label is last and may itself contain colons.
+		fields := strings.SplitN(v, ":", 3)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("malformed classification %q", v)
+		}
+		score, err := strconv.ParseFloat(fields[0], 32)
+		if err != nil {
+			return nil, fmt.Errorf("classification %q: bad score: %w", v, err)
+		}
+		index, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("classification %q: bad index: %w", v, err)
+		}
+		classes[i] = Classification{Index: index, Score: float32(score)}
+		if len(fields) == 3 {
+			classes[i].Label = fields[2]
+		}
+	}
+	return classes, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadLabels(t *testing.T) {
+	labels, err := ReadLabels(strings.NewReader("cat\r\ndog\nbird\n"))
+	if err != nil {
+		t.Fatalf("ReadLabels: %v", err)
+	}
+	if want := []string{"cat", "dog", "bird"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("labels = %q, want %q", labels, want)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	scores := []float32{0.1, 0.7, 0.2, 0.7}
+	labels := []string{"cat", "dog", "bird", "fish"}
+
+	got, err := Classify(scores, labels, 3)
+	if err != nil {
+		t.Fatalf("Classify: %v", err)
+	}
+	want := []Classification{
+		{Index: 1, Label: "dog", Score: 0.7},
+		{Index: 3, Label: "fish", Score: 0.7},
+		{Index: 2, Label: "bird", Score: 0.2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Classify = %+v, want %+v", got, want)
+	}
+
+	got, err = Classify(scores, nil, 10)
+	if err != nil {
+		t.Fatalf("Classify without labels: %v", err)
+	}
+	if len(got) != len(scores) || got[0].Label != "" {
+		t.Errorf("Classify without labels = %+v", got)
+	}
+}
+
+func TestClassifyErrors(t *testing.T) {
+	if _, err := Classify([]float32{1, 2}, []string{"cat"}, 1); err == nil {
+		t.Error("expected an error for too few labels")
+	}
+	if _, err := Classify([]float32{1, 2}, nil, 0); err == nil {
+		t.Error("expected an error for k = 0")
+	}
+}
+
+func TestDecodeClassifications(t *testing.T) {
+	raw, err := Preprocess([]string{"15.3:281:tabby, tabby cat", "9.5:7", "0.25:3:a:b"}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeClassifications(raw)
+	if err != nil {
+		t.Fatalf("DecodeClassifications: %v", err)
+	}
+	want := []Classification{
+		{Index: 281, Label: "tabby, tabby cat", Score: 15.3},
+		{Index: 7, Score: 9.5},
+		{Index: 3, Label: "a:b", Score: 0.25},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeClassifications = %+v, want %+v", got, want)
+	}
+
+	for _, bad := range []string{"0.5", "x:1:a", "0.5:y:a"} {
+		raw, _ := Preprocess([]string{bad}, 1)
+		if _, err := DecodeClassifications(raw); err == nil {
+			t.Errorf("DecodeClassifications accepted %q", bad)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+// blockingServer holds every ModelInfer until release is closed and
+// records the most calls it saw in flight at once.
+type blockingServer struct {
+	release  chan struct{}
+	calls    int32
+	inFlight int32
+	peak     int32
+}
+
+func newBlockingServer() *blockingServer {
+	return &blockingServer{release: make(chan struct{})}
+}
+
+func (b *blockingServer) fake() *fakeServer {
+	return &fakeServer{
+		modelInfer: func(ctx context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			atomic.AddInt32(&b.calls, 1)
+			n := atomic.AddInt32(&b.inFlight, 1)
+			defer atomic.AddInt32(&b.inFlight, -1)
+			for {
+				peak := atomic.LoadInt32(&b.peak)
+				if n <= peak || atomic.CompareAndSwapInt32(&b.peak, peak, n) {
+					break
+				}
+			}
+			select {
+			case <-b.release:
+				return &triton.ModelInferResponse{Id: req.Id}, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		},
+	}
+}
+
+// waitInFlight waits for n calls to reach the server, then checks that no
+// more arrive.
+func (b *blockingServer) waitInFlight(t *testing.T, n int32) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&b.inFlight) < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d calls in flight, want %d", atomic.LoadInt32(&b.inFlight), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if got := atomic.LoadInt32(&b.inFlight); got != n {
+		t.Fatalf("%d calls in flight, want %d", got, n)
+	}
+}
+
+func TestMaxConcurrent(t *testing.T) {
+	const limit, extra = 2, 3
+
+	t.Run("InferAsync", func(t *testing.T) {
+		srv := newBlockingServer()
+		client := startFakeServer(t, srv.fake(), WithMaxConcurrent(limit))
+
+		var calls []*InferCall
+		for i := 0; i < limit+extra; i++ {
+			calls = append(calls, client.InferAsync(context.Background(), &triton.ModelInferRequest{}))
+		}
+		srv.waitInFlight(t, limit)
+		close(srv.release)
+		for i, call := range calls {
+			if _, err := call.Result(); err != nil {
+				t.Errorf("call %d: %v", i, err)
+			}
+		}
+		if peak := atomic.LoadInt32(&srv.peak); peak != limit {
+			t.Errorf("peak in flight = %d, want %d", peak, limit)
+		}
+	})
+
+	// The limit applies across the whole pool, not per connection.
+	t.Run("InferBatchConcurrent with a pool", func(t *testing.T) {
+		srv := newBlockingServer()
+		client := startFakeServer(t, srv.fake(), WithMaxConcurrent(limit), WithConnPool(3))
+
+		reqs := make([]*triton.ModelInferRequest, limit+extra)
+		for i := range reqs {
+			reqs[i] = &triton.ModelInferRequest{}
+		}
+		done := make(chan []error)
+		go func() {
+			_, errs := client.InferBatchConcurrent(context.Background(), reqs, len(reqs))
+			done <- errs
+		}()
+		srv.waitInFlight(t, limit)
+		close(srv.release)
+		for i, err := range <-done {
+			if err != nil {
+				t.Errorf("request %d: %v", i, err)
+			}
+		}
+		if peak := atomic.LoadInt32(&srv.peak); peak != limit {
+			t.Errorf("peak in flight = %d, want %d", peak, limit)
+		}
+	})
+}
+
+func TestMaxConcurrentQueuedCancel(t *testing.T) {
+	srv := newBlockingServer()
+	client := startFakeServer(t, srv.fake(), WithMaxConcurrent(1))
+	defer close(srv.release)
+
+	first := client.InferAsync(context.Background(), &triton.ModelInferRequest{})
+	srv.waitInFlight(t, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queued := client.InferAsync(ctx, &triton.ModelInferRequest{})
+	select {
+	case <-queued.Done():
+		t.Fatal("queued call completed while the limit was held")
+	case <-time.After(10 * time.Millisecond):
+	}
+	cancel()
+	if _, err := queued.Result(); !errors.Is(err, context.Canceled) {
+		t.Errorf("queued call: %v, want context.Canceled", err)
+	}
+	if calls := atomic.LoadInt32(&srv.calls); calls != 1 {
+		t.Errorf("server saw %d calls, want 1", calls)
+	}
+	select {
+	case <-first.Done():
+		t.Error("in-flight call completed before release")
+	default:
+	}
+}
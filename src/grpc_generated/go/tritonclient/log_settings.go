@@ -0,0 +1,101 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+
+	triton "nvidia_inferenceserver"
+)
+
+// LogSettings are the server's runtime logging settings.
+type LogSettings struct {
+	// File is the file the server logs to; empty means the console.
+	File         string
+	Info         bool
+	Warning      bool
+	Error        bool
+	VerboseLevel uint32
+	// Format is "default" or "ISO8601".
+	Format string
+}
+
+// GetLogSettings returns the server's logging settings. It fails with an
+// error wrapping ErrUnsupportedExtension if the server doesn't advertise
+// the logging extension.
+func (c *TritonClient) GetLogSettings(ctx context.Context) (LogSettings, error) {
+	return c.logSettings(ctx, nil)
+}
+
+// UpdateLogSettings replaces the server's logging settings with settings,
+// typically ones read with GetLogSettings and modified, and returns the
+// settings now in effect. An empty File or Format leaves the log file or
+// format unchanged.
+func (c *TritonClient) UpdateLogSettings(ctx context.Context, settings LogSettings) (LogSettings, error) {
+	values := map[string]*triton.LogSettingsRequest_SettingValue{
+		"log_info":          logBool(settings.Info),
+		"log_warning":       logBool(settings.Warning),
+		"log_error":         logBool(settings.Error),
+		"log_verbose_level": {ParameterChoice: &triton.LogSettingsRequest_SettingValue_Uint32Param{Uint32Param: settings.VerboseLevel}},
+	}
+	if settings.File != "" {
+		values["log_file"] = logString(settings.File)
+	}
+	if settings.Format != "" {
+		values["log_format"] = logString(settings.Format)
+	}
+	return c.logSettings(ctx, values)
+}
+
+func (c *TritonClient) logSettings(ctx context.Context, values map[string]*triton.LogSettingsRequest_SettingValue) (LogSettings, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	if err := c.requireExtension(ctx, "logging"); err != nil {
+		return LogSettings{}, err
+	}
+	resp, err := c.client.LogSettings(ctx, &triton.LogSettingsRequest{Settings: values})
+	if err != nil {
+		return LogSettings{}, err
+	}
+	s := resp.Settings
+	return LogSettings{
+		File:         s["log_file"].GetStringParam(),
+		Info:         s["log_info"].GetBoolParam(),
+		Warning:      s["log_warning"].GetBoolParam(),
+		Error:        s["log_error"].GetBoolParam(),
+		VerboseLevel: s["log_verbose_level"].GetUint32Param(),
+		Format:       s["log_format"].GetStringParam(),
+	}, nil
+}
+
+func logBool(v bool) *triton.LogSettingsRequest_SettingValue {
+	return &triton.LogSettingsRequest_SettingValue{ParameterChoice: &triton.LogSettingsRequest_SettingValue_BoolParam{BoolParam: v}}
+}
+
+func logString(v string) *triton.LogSettingsRequest_SettingValue {
+	return &triton.LogSettingsRequest_SettingValue{ParameterChoice: &triton.LogSettingsRequest_SettingValue_StringParam{StringParam: v}}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestLogSettings(t *testing.T) {
+	// The fake server keeps its settings as the real one does, applying
+	// each update and answering with the result.
+	current := map[string]*triton.LogSettingsResponse_SettingValue{
+		"log_file":          {ParameterChoice: &triton.LogSettingsResponse_SettingValue_StringParam{StringParam: ""}},
+		"log_info":          {ParameterChoice: &triton.LogSettingsResponse_SettingValue_BoolParam{BoolParam: true}},
+		"log_warning":       {ParameterChoice: &triton.LogSettingsResponse_SettingValue_BoolParam{BoolParam: true}},
+		"log_error":         {ParameterChoice: &triton.LogSettingsResponse_SettingValue_BoolParam{BoolParam: true}},
+		"log_verbose_level": {ParameterChoice: &triton.LogSettingsResponse_SettingValue_Uint32Param{Uint32Param: 0}},
+		"log_format":        {ParameterChoice: &triton.LogSettingsResponse_SettingValue_StringParam{StringParam: "default"}},
+	}
+	var updated []string
+	client := startFakeServer(t, &fakeServer{
+		serverMetadata: withExtensions("logging"),
+		logSettings: func(_ context.Context, req *triton.LogSettingsRequest) (*triton.LogSettingsResponse, error) {
+			for name, v := range req.Settings {
+				updated = append(updated, name)
+				switch p := v.ParameterChoice.(type) {
+				case *triton.LogSettingsRequest_SettingValue_BoolParam:
+					current[name] = &triton.LogSettingsResponse_SettingValue{ParameterChoice: &triton.LogSettingsResponse_SettingValue_BoolParam{BoolParam: p.BoolParam}}
+				case *triton.LogSettingsRequest_SettingValue_Uint32Param:
+					current[name] = &triton.LogSettingsResponse_SettingValue{ParameterChoice: &triton.LogSettingsResponse_SettingValue_Uint32Param{Uint32Param: p.Uint32Param}}
+				case *triton.LogSettingsRequest_SettingValue_StringParam:
+					current[name] = &triton.LogSettingsResponse_SettingValue{ParameterChoice: &triton.LogSettingsResponse_SettingValue_StringParam{StringParam: p.StringParam}}
+				}
+			}
+			return &triton.LogSettingsResponse{Settings: current}, nil
+		},
+	})
+	ctx := context.Background()
+
+	settings, err := client.GetLogSettings(ctx)
+	if err != nil {
+		t.Fatalf("GetLogSettings: %v", err)
+	}
+	want := LogSettings{Info: true, Warning: true, Error: true, Format: "default"}
+	if settings != want || len(updated) != 0 {
+		t.Errorf("GetLogSettings = %+v after updating %v, want %+v", settings, updated, want)
+	}
+
+	settings.VerboseLevel = 2
+	settings.Format = "ISO8601"
+	got, err := client.UpdateLogSettings(ctx, settings)
+	if err != nil {
+		t.Fatalf("UpdateLogSettings: %v", err)
+	}
+	if got != settings {
+		t.Errorf("UpdateLogSettings = %+v, want %+v", got, settings)
+	}
+	for _, name := range updated {
+		if name == "log_file" {
+			t.Error("UpdateLogSettings sent an empty log_file")
+		}
+	}
+
+	// An empty Format keeps the current one.
+	updated = nil
+	settings.Format = ""
+	got, err = client.UpdateLogSettings(ctx, settings)
+	if err != nil {
+		t.Fatalf("UpdateLogSettings: %v", err)
+	}
+	if got.Format != "ISO8601" {
+		t.Errorf("format after an empty update = %q, want ISO8601", got.Format)
+	}
+	for _, name := range updated {
+		if name == "log_format" {
+			t.Error("UpdateLogSettings sent an empty log_format")
+		}
+	}
+}
+
+func TestLogSettingsUnsupported(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{serverMetadata: withExtensions()})
+	if _, err := client.GetLogSettings(context.Background()); !errors.Is(err, ErrUnsupportedExtension) {
+		t.Errorf("GetLogSettings error = %v, want ErrUnsupportedExtension", err)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingLogger keeps every message logged to it, prefixed by level.
+type recordingLogger struct {
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (r *recordingLogger) record(level, format string, args []interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.msgs = append(r.msgs, level+" "+fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) Debugf(format string, args ...interface{}) { r.record("DEBUG", format, args) }
+func (r *recordingLogger) Infof(format string, args ...interface{})  { r.record("INFO", format, args) }
+func (r *recordingLogger) Errorf(format string, args ...interface{}) { r.record("ERROR", format, args) }
+
+func (r *recordingLogger) messages() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.msgs...)
+}
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := StdLogger(log.New(&buf, "", 0))
+	l.Debugf("a %d", 1)
+	l.Infof("b")
+	l.Errorf("c %s", "x")
+	if got, want := buf.String(), "DEBUG a 1\nINFO b\nERROR c x\n"; got != want {
+		t.Errorf("StdLogger wrote %q, want %q", got, want)
+	}
+}
+
+func TestTraceIDsLogged(t *testing.T) {
+	rec := &recordingLogger{}
+	client := startFakeServer(t, &fakeServer{}, WithTraceIDs(), WithLogger(rec))
+	client.Live(ContextWithTraceID(context.Background(), "abc"))
+
+	msgs := rec.messages()
+	if len(msgs) != 1 || !strings.HasPrefix(msgs[0], "DEBUG ") || !strings.HasSuffix(msgs[0], TraceIDMetadataKey+"=abc") {
+		t.Errorf("logged %q, want one debug line with the trace id", msgs)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RegionMetadataKey is the outgoing metadata key carrying the client's
+// region, used by gateways for geo-aware routing.
+const RegionMetadataKey = "client-region"
+
+// WithRegion attaches region as outgoing metadata on every request.
+func WithRegion(region string) Option {
+	return WithMetadata(metadata.Pairs(RegionMetadataKey, region))
+}
+
+// WithMetadata attaches md as outgoing metadata on every request, for
+// example a tenant id expected by a proxy in front of the server.
+// Metadata for a single call can be added to its context with
+// metadata.AppendToOutgoingContext as usual.
+func WithMetadata(md metadata.MD) Option {
+	md = md.Copy()
+	return WithMetadataFunc(func(context.Context) (metadata.MD, error) {
+		return md, nil
+	})
+}
+
+// WithMetadataFunc calls fn before every request and attaches the
+// metadata it returns, so values such as a bearer token can be rotated
+// without rebuilding the client. If fn fails, the request is not sent and
+// its error is returned.
+func WithMetadataFunc(fn func(ctx context.Context) (metadata.MD, error)) Option {
+	outgoing := func(ctx context.Context) (context.Context, error) {
+		md, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var kv []string
+		for key, values := range md {
+			for _, value := range values {
+				kv = append(kv, key, value)
+			}
+		}
+		return metadata.AppendToOutgoingContext(ctx, kv...), nil
+	}
+	return func(o *options) {
+		o.unaryInterceptors = append(o.unaryInterceptors,
+			func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+				ctx, err := outgoing(ctx)
+				if err != nil {
+					return err
+				}
+				return invoker(ctx, method, req, reply, cc, opts...)
+			})
+		o.streamInterceptors = append(o.streamInterceptors,
+			func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				ctx, err := outgoing(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return streamer(ctx, desc, cc, method, opts...)
+			})
+	}
+}
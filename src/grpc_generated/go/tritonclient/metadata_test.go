@@ -0,0 +1,87 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWithMetadata(t *testing.T) {
+	var got metadata.MD
+	token := 0
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(ctx context.Context, _ *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			got, _ = metadata.FromIncomingContext(ctx)
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	},
+		WithMetadata(metadata.Pairs("Tenant-ID", "acme")),
+		WithMetadataFunc(func(context.Context) (metadata.MD, error) {
+			token++
+			return metadata.Pairs("authorization", fmt.Sprintf("Bearer t%d", token)), nil
+		}),
+		WithRegion("eu-west"))
+
+	for _, want := range []string{"Bearer t1", "Bearer t2"} {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "x-call", "c")
+		if _, err := client.Live(ctx); err != nil {
+			t.Fatalf("Live: %v", err)
+		}
+		for key, value := range map[string]string{"tenant-id": "acme", "authorization": want, RegionMetadataKey: "eu-west", "x-call": "c"} {
+			if v := got.Get(key); !reflect.DeepEqual(v, []string{value}) {
+				t.Errorf("%s = %q, want %q", key, v, value)
+			}
+		}
+	}
+}
+
+func TestWithMetadataFuncError(t *testing.T) {
+	called := false
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			called = true
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	}, WithMetadataFunc(func(context.Context) (metadata.MD, error) {
+		return nil, errors.New("token expired")
+	}))
+
+	if _, err := client.Live(context.Background()); err == nil || err.Error() != "token expired" {
+		t.Errorf("Live error = %v, want token expired", err)
+	}
+	if called {
+		t.Error("request was sent despite the metadata error")
+	}
+}
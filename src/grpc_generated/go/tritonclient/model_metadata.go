@@ -0,0 +1,193 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+// WithMetadataCache caches ModelMetadata and ModelConfig responses per
+// model and version so repeated inferences don't re-fetch the signature.
+// Entries expire after ttl, or never if ttl is zero; call Reset after
+// reloading a model to pick up a changed signature sooner.
+func WithMetadataCache(ttl time.Duration) Option {
+	return func(o *options) {
+		o.metadataCache = true
+		o.metadataTTL = ttl
+	}
+}
+
+type metadataKey struct {
+	name    string
+	version string
+}
+
+type metadataEntry struct {
+	resp    interface{}
+	expires time.Time
+}
+
+// metadataCache holds per-model responses of one kind. A nil cache stores
+// nothing.
+type metadataCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[metadataKey]metadataEntry
+}
+
+func newMetadataCache(enabled bool, ttl time.Duration) *metadataCache {
+	if !enabled {
+		return nil
+	}
+	return &metadataCache{ttl: ttl, entries: make(map[metadataKey]metadataEntry)}
+}
+
+func (m *metadataCache) get(key metadataKey) (interface{}, bool) {
+	if m == nil {
+		return nil, false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.resp, true
+}
+
+func (m *metadataCache) put(key metadataKey, resp interface{}) {
+	if m == nil {
+		return
+	}
+	entry := metadataEntry{resp: resp}
+	if m.ttl > 0 {
+		entry.expires = time.Now().Add(m.ttl)
+	}
+	m.mu.Lock()
+	m.entries[key] = entry
+	m.mu.Unlock()
+}
+
+func (m *metadataCache) reset(key metadataKey) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	delete(m.entries, key)
+	m.mu.Unlock()
+}
+
+// ModelMetadata returns the metadata of a model version, from the cache if
+// WithMetadataCache is enabled and it holds a live entry. An empty version
+// means the version the server picks.
+func (c *TritonClient) ModelMetadata(ctx context.Context, name, version string) (*triton.ModelMetadataResponse, error) {
+	version, err := normalizeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	key := metadataKey{name: name, version: version}
+	if resp, ok := c.metadata.get(key); ok {
+		return resp.(*triton.ModelMetadataResponse), nil
+	}
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	resp, err := c.client.ModelMetadata(ctx, &triton.ModelMetadataRequest{Name: name, Version: version})
+	if err != nil {
+		return nil, err
+	}
+	c.metadata.put(key, resp)
+	return resp, nil
+}
+
+// ModelMetadataByVersion returns the metadata of every ready version of
+// the named model, keyed by version. Ready versions are discovered with
+// Index, then each version's metadata is fetched, and cached, as by
+// ModelMetadata. It returns an error if no version of the model is ready.
+func (c *TritonClient) ModelMetadataByVersion(ctx context.Context, name string) (map[string]*triton.ModelMetadataResponse, error) {
+	index, err := c.Index(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	metadata := make(map[string]*triton.ModelMetadataResponse)
+	for _, model := range index {
+		if model.Name != name || model.Version == "" {
+			continue
+		}
+		resp, err := c.ModelMetadata(ctx, name, model.Version)
+		if err != nil {
+			return nil, fmt.Errorf("version %s of model %s: %w", model.Version, name, err)
+		}
+		metadata[model.Version] = resp
+	}
+	if len(metadata) == 0 {
+		return nil, fmt.Errorf("model %s has no ready versions", name)
+	}
+	return metadata, nil
+}
+
+// ModelConfig returns the configuration of a model version, including its
+// max_batch_size, batching and instance group settings. It is cached like
+// ModelMetadata.
+func (c *TritonClient) ModelConfig(ctx context.Context, name, version string) (*triton.ModelConfigResponse, error) {
+	version, err := normalizeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	key := metadataKey{name: name, version: version}
+	if resp, ok := c.configs.get(key); ok {
+		return resp.(*triton.ModelConfigResponse), nil
+	}
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	resp, err := c.client.ModelConfig(ctx, &triton.ModelConfigRequest{Name: name, Version: version})
+	if err != nil {
+		return nil, err
+	}
+	c.configs.put(key, resp)
+	return resp, nil
+}
+
+// Reset drops the cached metadata and config of a model version so the
+// next ModelMetadata and ModelConfig calls re-read them from the server.
+func (c *TritonClient) Reset(name, version string) {
+	if v, err := normalizeVersion(version); err == nil {
+		version = v
+	}
+	key := metadataKey{name: name, version: version}
+	c.metadata.reset(key)
+	c.configs.reset(key)
+}
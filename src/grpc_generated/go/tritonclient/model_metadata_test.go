@@ -0,0 +1,160 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+func metadataServer(calls *int32) *fakeServer {
+	return &fakeServer{
+		modelMetadata: func(_ context.Context, req *triton.ModelMetadataRequest) (*triton.ModelMetadataResponse, error) {
+			atomic.AddInt32(calls, 1)
+			return &triton.ModelMetadataResponse{Name: req.Name, Versions: []string{"1"}}, nil
+		},
+	}
+}
+
+func TestModelMetadataCache(t *testing.T) {
+	var calls int32
+	client := startFakeServer(t, metadataServer(&calls), WithMetadataCache(0))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.ModelMetadata(ctx, "simple", "")
+		if err != nil {
+			t.Fatalf("ModelMetadata: %v", err)
+		}
+		if resp.Name != "simple" {
+			t.Errorf("Name = %q", resp.Name)
+		}
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("server saw %d calls, want 1", got)
+	}
+
+	if _, err := client.ModelMetadata(ctx, "simple", "1"); err != nil {
+		t.Fatalf("ModelMetadata: %v", err)
+	}
+	client.Reset("simple", "")
+	if _, err := client.ModelMetadata(ctx, "simple", ""); err != nil {
+		t.Fatalf("ModelMetadata: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("server saw %d calls after Reset, want 3", got)
+	}
+}
+
+func TestModelMetadataCacheTTL(t *testing.T) {
+	var calls int32
+	client := startFakeServer(t, metadataServer(&calls), WithMetadataCache(10*time.Millisecond))
+	ctx := context.Background()
+
+	client.ModelMetadata(ctx, "simple", "")
+	client.ModelMetadata(ctx, "simple", "")
+	time.Sleep(20 * time.Millisecond)
+	client.ModelMetadata(ctx, "simple", "")
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("server saw %d calls, want 2", got)
+	}
+}
+
+func TestModelMetadataUncached(t *testing.T) {
+	var calls int32
+	client := startFakeServer(t, metadataServer(&calls))
+
+	client.ModelMetadata(context.Background(), "simple", "")
+	client.ModelMetadata(context.Background(), "simple", "")
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("server saw %d calls, want 2", got)
+	}
+}
+
+func TestModelConfig(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		modelConfig: func(_ context.Context, req *triton.ModelConfigRequest) (*triton.ModelConfigResponse, error) {
+			return &triton.ModelConfigResponse{Config: &triton.ModelConfig{
+				Name:         req.Name,
+				MaxBatchSize: 8,
+				Optimization: &triton.ModelOptimizationPolicy{Priority: triton.ModelOptimizationPolicy_PRIORITY_MAX},
+			}}, nil
+		},
+	})
+
+	resp, err := client.ModelConfig(context.Background(), "simple", "")
+	if err != nil {
+		t.Fatalf("ModelConfig: %v", err)
+	}
+	if resp.Config.Name != "simple" || resp.Config.MaxBatchSize != 8 {
+		t.Errorf("config = %v", resp.Config)
+	}
+
+	optimization, err := client.ModelOptimization(context.Background(), "simple", "")
+	if err != nil {
+		t.Fatalf("ModelOptimization: %v", err)
+	}
+	if optimization.Priority != "PRIORITY_MAX" {
+		t.Errorf("Priority = %q, want PRIORITY_MAX", optimization.Priority)
+	}
+}
+
+func TestModelMetadataByVersion(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		repositoryIndex: func(context.Context, *triton.RepositoryIndexRequest) (*triton.RepositoryIndexResponse, error) {
+			return &triton.RepositoryIndexResponse{Models: []*triton.RepositoryIndexResponse_ModelIndex{
+				{Name: "simple", Version: "1", State: "READY"},
+				{Name: "other", Version: "1", State: "READY"},
+				{Name: "simple", Version: "3", State: "READY"},
+			}}, nil
+		},
+		modelMetadata: func(_ context.Context, req *triton.ModelMetadataRequest) (*triton.ModelMetadataResponse, error) {
+			return &triton.ModelMetadataResponse{Name: req.Name, Versions: []string{req.Version}}, nil
+		},
+	})
+
+	metadata, err := client.ModelMetadataByVersion(context.Background(), "simple")
+	if err != nil {
+		t.Fatalf("ModelMetadataByVersion: %v", err)
+	}
+	if len(metadata) != 2 {
+		t.Fatalf("got metadata for %d versions, want 2", len(metadata))
+	}
+	for _, version := range []string{"1", "3"} {
+		if got := metadata[version]; got == nil || got.Name != "simple" || got.Versions[0] != version {
+			t.Errorf("metadata[%s] = %v", version, got)
+		}
+	}
+
+	if _, err := client.ModelMetadataByVersion(context.Background(), "missing"); err == nil {
+		t.Error("ModelMetadataByVersion of a model with no ready versions succeeded")
+	}
+}
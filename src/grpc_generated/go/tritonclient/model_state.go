@@ -0,0 +1,101 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+
+	triton "nvidia_inferenceserver"
+)
+
+// ModelState is the load state of a model in the server's repository.
+type ModelState int
+
+const (
+	// ModelStateNotFound means the repository has no such model or version.
+	ModelStateNotFound ModelState = iota
+	// ModelStateUnavailable means the model is known but not serving,
+	// e.g. it failed to load or has been unloaded.
+	ModelStateUnavailable
+	// ModelStateLoading means the model is still being loaded.
+	ModelStateLoading
+	// ModelStateReady means the model is ready for inference.
+	ModelStateReady
+)
+
+func (s ModelState) String() string {
+	switch s {
+	case ModelStateNotFound:
+		return "NOT_FOUND"
+	case ModelStateUnavailable:
+		return "UNAVAILABLE"
+	case ModelStateLoading:
+		return "LOADING"
+	case ModelStateReady:
+		return "READY"
+	}
+	return "UNKNOWN"
+}
+
+// ModelState reports the state of a model. It asks ModelReady first and,
+// if the model isn't ready, consults the repository index to tell a model
+// that is still loading apart from one that is unavailable or missing.
+func (c *TritonClient) ModelState(ctx context.Context, name, version string) (ModelState, error) {
+	version, err := normalizeVersion(version)
+	if err != nil {
+		return ModelStateNotFound, err
+	}
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	ready, err := c.client.ModelReady(ctx, &triton.ModelReadyRequest{Name: name, Version: version})
+	if err != nil {
+		return ModelStateNotFound, err
+	}
+	if ready.Ready {
+		return ModelStateReady, nil
+	}
+
+	models, err := c.Index(ctx, false)
+	if err != nil {
+		return ModelStateNotFound, err
+	}
+	state := ModelStateNotFound
+	for _, model := range models {
+		if model.Name != name || (version != "" && model.Version != version) {
+			continue
+		}
+		switch model.State {
+		case "LOADING":
+			return ModelStateLoading, nil
+		case "READY":
+			return ModelStateReady, nil
+		default:
+			state = ModelStateUnavailable
+		}
+	}
+	return state, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestModelState(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		modelReady: func(_ context.Context, req *triton.ModelReadyRequest) (*triton.ModelReadyResponse, error) {
+			return &triton.ModelReadyResponse{Ready: req.Name == "ready"}, nil
+		},
+		repositoryIndex: func(context.Context, *triton.RepositoryIndexRequest) (*triton.RepositoryIndexResponse, error) {
+			return &triton.RepositoryIndexResponse{Models: []*triton.RepositoryIndexResponse_ModelIndex{
+				{Name: "ready", Version: "1", State: "READY"},
+				{Name: "loading", Version: "1", State: "LOADING"},
+				{Name: "failed", Version: "1", State: "UNAVAILABLE", Reason: "load error"},
+			}}, nil
+		},
+	})
+
+	tests := []struct {
+		name, version string
+		want          ModelState
+	}{
+		{"ready", "", ModelStateReady},
+		{"loading", "", ModelStateLoading},
+		{"loading", "2", ModelStateNotFound},
+		{"failed", "1", ModelStateUnavailable},
+		{"loading", "01", ModelStateLoading},
+		{"missing", "", ModelStateNotFound},
+	}
+	for _, tt := range tests {
+		got, err := client.ModelState(context.Background(), tt.name, tt.version)
+		if err != nil {
+			t.Fatalf("ModelState(%s, %q): %v", tt.name, tt.version, err)
+		}
+		if got != tt.want {
+			t.Errorf("ModelState(%s, %q) = %v, want %v", tt.name, tt.version, got, tt.want)
+		}
+	}
+
+	if _, err := client.ModelState(context.Background(), "ready", "latest"); err == nil {
+		t.Error("ModelState accepted version \"latest\"")
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	triton "nvidia_inferenceserver"
+)
+
+// npyDatatypes maps numpy dtype codes, without their byte order
+// character, to Triton datatypes.
+var npyDatatypes = map[string]string{
+	"b1": "BOOL",
+	"i1": "INT8",
+	"i2": "INT16",
+	"i4": "INT32",
+	"i8": "INT64",
+	"u1": "UINT8",
+	"u2": "UINT16",
+	"u4": "UINT32",
+	"u8": "UINT64",
+	"f2": "FP16",
+	"f4": "FP32",
+	"f8": "FP64",
+}
+
+// LoadNpy reads a numpy .npy file, as written by numpy.save, into an input
+// tensor named name, so fixtures from a Python pipeline can be reused.
+// Like NewInput it returns the tensor and its raw contents. Only C-order
+// arrays of fixed-size numeric and bool dtypes are supported.
+func LoadNpy(name, path string) (*triton.ModelInferRequest_InferInputTensor, []byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	tensor, raw, err := ReadNpy(name, f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return tensor, raw, nil
+}
+
+// ReadNpy is like LoadNpy but reads the .npy data from r.
+func ReadNpy(name string, r io.Reader) (*triton.ModelInferRequest_InferInputTensor, []byte, error) {
+	header, err := readNpyHeader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	descr, err := npyField(header, "descr")
+	if err != nil {
+		return nil, nil, err
+	}
+	descr = strings.Trim(descr, "'\"")
+	if len(descr) < 2 {
+		return nil, nil, fmt.Errorf("invalid npy dtype %q", descr)
+	}
+	order := binary.ByteOrder(binary.LittleEndian)
+	code := descr
+	switch descr[0] {
+	case '<', '|', '=':
+		code = descr[1:]
+	case '>':
+		order, code = binary.BigEndian, descr[1:]
+	}
+	datatype, ok := npyDatatypes[code]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported npy dtype %q", descr)
+	}
+
+	fortranOrder, err := npyField(header, "fortran_order")
+	if err != nil {
+		return nil, nil, err
+	}
+	if fortranOrder != "False" {
+		return nil, nil, errors.New("npy arrays in Fortran order are not supported, save a C-order copy")
+	}
+
+	shapeField, err := npyField(header, "shape")
+	if err != nil {
+		return nil, nil, err
+	}
+	shape, err := parseNpyShape(shapeField)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	size, _ := datatypeSize(datatype)
+	n := int64(1)
+	for _, dim := range shape {
+		if dim != 0 && n > math.MaxInt/int64(size)/dim {
+			return nil, nil, fmt.Errorf("npy shape %v is too large", shape)
+		}
+		n *= dim
+	}
+	raw := make([]byte, n*int64(size))
+	written, err := EncodeFromReaderInto(raw, r, datatype, order)
+	if err != nil {
+		return nil, nil, fmt.Errorf("npy data doesn't fit shape %v of %s: %w", shape, datatype, err)
+	}
+	if written != len(raw) {
+		return nil, nil, fmt.Errorf("npy data has %d bytes, shape %v of %s holds %d", written, shape, datatype, len(raw))
+	}
+	tensor := &triton.ModelInferRequest_InferInputTensor{
+		Name:     name,
+		Datatype: datatype,
+		Shape:    shape,
+	}
+	return tensor, raw, nil
+}
+
+// readNpyHeader reads the magic string, version and header length and
+// returns the header, a Python dict literal.
+func readNpyHeader(r io.Reader) (string, error) {
+	var prefix [8]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return "", fmt.Errorf("reading npy magic: %w", err)
+	}
+	if !bytes.Equal(prefix[:6], []byte("\x93NUMPY")) {
+		return "", errors.New("not an npy file")
+	}
+	var headerLen int
+	switch major := prefix[6]; major {
+	case 1:
+		var n uint16
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return "", fmt.Errorf("reading npy header length: %w", err)
+		}
+		headerLen = int(n)
+	case 2, 3:
+		var n uint32
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return "", fmt.Errorf("reading npy header length: %w", err)
+		}
+		headerLen = int(n)
+	default:
+		return "", fmt.Errorf("unsupported npy version %d", major)
+	}
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return "", fmt.Errorf("reading npy header: %w", err)
+	}
+	return string(header), nil
+}
+
+// npyField returns the value of key in the header dict, as written: a
+// quoted string, True or False, or a parenthesized tuple.
+func npyField(header, key string) (string, error) {
+	i := strings.Index(header, "'"+key+"'")
+	if i < 0 {
+		return "", fmt.Errorf("npy header has no %s", key)
+	}
+	rest := strings.TrimSpace(header[i+len(key)+2:])
+	rest = strings.TrimSpace(strings.TrimPrefix(rest, ":"))
+	if strings.HasPrefix(rest, "(") {
+		end := strings.Index(rest, ")")
+		if end < 0 {
+			return "", fmt.Errorf("npy header has an unterminated %s", key)
+		}
+		return rest[:end+1], nil
+	}
+	if end := strings.IndexAny(rest, ",}"); end >= 0 {
+		rest = rest[:end]
+	}
+	return strings.TrimSpace(rest), nil
+}
+
+// parseNpyShape parses a shape tuple such as (), (3,) or (2, 3).
+func parseNpyShape(tuple string) ([]int64, error) {
+	inner := strings.TrimSuffix(strings.TrimPrefix(tuple, "("), ")")
+	shape := []int64{}
+	for _, dim := range strings.Split(inner, ",") {
+		dim = strings.TrimSpace(dim)
+		if dim == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSuffix(dim, "L"), 10, 64)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid npy shape %s", tuple)
+		}
+		shape = append(shape, n)
+	}
+	return shape, nil
+}
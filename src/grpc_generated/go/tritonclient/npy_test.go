@@ -0,0 +1,104 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// npyFile builds the contents of a version 1.0 .npy file as numpy.save
+// writes it, padding the header to a multiple of 64 bytes.
+func npyFile(header string, data []byte) []byte {
+	pad := 64 - (10+len(header)+1)%64
+	header += strings.Repeat(" ", pad%64) + "\n"
+	var buf bytes.Buffer
+	buf.WriteString("\x93NUMPY\x01\x00")
+	binary.Write(&buf, binary.LittleEndian, uint16(len(header)))
+	buf.WriteString(header)
+	buf.Write(data)
+	return buf.Bytes()
+}
+
+func TestLoadNpy(t *testing.T) {
+	tests := []struct {
+		header   string
+		data     []byte
+		datatype string
+		shape    []int64
+		raw      []byte
+	}{
+		{"{'descr': '<f4', 'fortran_order': False, 'shape': (2,), }", float32Bytes([]float32{1, -2}), "FP32", []int64{2}, float32Bytes([]float32{1, -2})},
+		{"{'descr': '<i8', 'fortran_order': False, 'shape': (1, 1), }", []byte{7, 0, 0, 0, 0, 0, 0, 0}, "INT64", []int64{1, 1}, []byte{7, 0, 0, 0, 0, 0, 0, 0}},
+		{"{'descr': '|u1', 'fortran_order': False, 'shape': (2, 2), }", []byte{1, 2, 3, 4}, "UINT8", []int64{2, 2}, []byte{1, 2, 3, 4}},
+		{"{'descr': '|b1', 'fortran_order': False, 'shape': (3,), }", []byte{1, 0, 1}, "BOOL", []int64{3}, []byte{1, 0, 1}},
+		{"{'descr': '>i4', 'fortran_order': False, 'shape': (), }", []byte{0, 0, 1, 2}, "INT32", []int64{}, []byte{2, 1, 0, 0}},
+	}
+	for _, tt := range tests {
+		tensor, raw, err := ReadNpy("INPUT0", bytes.NewReader(npyFile(tt.header, tt.data)))
+		if err != nil {
+			t.Errorf("ReadNpy(%s): %v", tt.header, err)
+			continue
+		}
+		if tensor.Name != "INPUT0" || tensor.Datatype != tt.datatype || !reflect.DeepEqual(tensor.Shape, tt.shape) {
+			t.Errorf("ReadNpy(%s) tensor = %v", tt.header, tensor)
+		}
+		if !bytes.Equal(raw, tt.raw) {
+			t.Errorf("ReadNpy(%s) contents = %v, want %v", tt.header, raw, tt.raw)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "input.npy")
+	if err := os.WriteFile(path, npyFile(tests[0].header, tests[0].data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if tensor, _, err := LoadNpy("INPUT0", path); err != nil || tensor.Datatype != "FP32" {
+		t.Errorf("LoadNpy = %v, %v", tensor, err)
+	}
+}
+
+func TestLoadNpyErrors(t *testing.T) {
+	tests := map[string][]byte{
+		"fortran order":       npyFile("{'descr': '<f4', 'fortran_order': True, 'shape': (2, 2), }", make([]byte, 16)),
+		"unsupported dtype":   npyFile("{'descr': '<c8', 'fortran_order': False, 'shape': (1,), }", make([]byte, 8)),
+		"object dtype":        npyFile("{'descr': '|O', 'fortran_order': False, 'shape': (1,), }", make([]byte, 8)),
+		"short data":          npyFile("{'descr': '<f4', 'fortran_order': False, 'shape': (3,), }", make([]byte, 8)),
+		"not an npy file":     []byte("PK\x03\x04 not numpy"),
+		"truncated header":    npyFile("{'descr': '<f4', 'fortran_order': False, 'shape': (3,), }", nil)[:20],
+		"missing shape field": npyFile("{'descr': '<f4', 'fortran_order': False, }", nil),
+	}
+	for name, file := range tests {
+		if _, _, err := ReadNpy("INPUT0", bytes.NewReader(file)); err == nil {
+			t.Errorf("%s: ReadNpy succeeded", name)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+
+	triton "nvidia_inferenceserver"
+)
+
+// Accelerator is an execution accelerator configured for a model, such as
+// "tensorrt" with a "precision_mode" parameter.
+type Accelerator struct {
+	Name       string
+	Parameters map[string]string
+}
+
+// OptimizationSettings summarizes the optimization section of a model
+// config.
+type OptimizationSettings struct {
+	Priority           string
+	GraphLevel         int32
+	CUDAGraphs         bool
+	GPUAccelerators    []Accelerator
+	CPUAccelerators    []Accelerator
+	InputPinnedMemory  bool
+	OutputPinnedMemory bool
+}
+
+// Optimization extracts the optimization settings from config. Pinned
+// memory is reported as enabled when unset, matching the server default.
+func Optimization(config *triton.ModelConfig) OptimizationSettings {
+	policy := config.GetOptimization()
+	accelerators := policy.GetExecutionAccelerators()
+	return OptimizationSettings{
+		Priority:           policy.GetPriority().String(),
+		GraphLevel:         policy.GetGraph().GetLevel(),
+		CUDAGraphs:         policy.GetCuda().GetGraphs(),
+		GPUAccelerators:    toAccelerators(accelerators.GetGpuExecutionAccelerator()),
+		CPUAccelerators:    toAccelerators(accelerators.GetCpuExecutionAccelerator()),
+		InputPinnedMemory:  policy.GetInputPinnedMemory() == nil || policy.GetInputPinnedMemory().GetEnable(),
+		OutputPinnedMemory: policy.GetOutputPinnedMemory() == nil || policy.GetOutputPinnedMemory().GetEnable(),
+	}
+}
+
+func toAccelerators(in []*triton.ModelOptimizationPolicy_ExecutionAccelerators_Accelerator) []Accelerator {
+	var out []Accelerator
+	for _, a := range in {
+		out = append(out, Accelerator{Name: a.GetName(), Parameters: a.GetParameters()})
+	}
+	return out
+}
+
+// ModelOptimization fetches the config of a model and returns its
+// optimization settings.
+func (c *TritonClient) ModelOptimization(ctx context.Context, name, version string) (OptimizationSettings, error) {
+	resp, err := c.ModelConfig(ctx, name, version)
+	if err != nil {
+		return OptimizationSettings{}, err
+	}
+	return Optimization(resp.GetConfig()), nil
+}
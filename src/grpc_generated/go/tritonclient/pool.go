@@ -0,0 +1,113 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// WithConnPool spreads calls round-robin over size connections to the
+// server instead of one. A single HTTP/2 connection multiplexes every call
+// and becomes the bottleneck under heavy concurrency; a pool lets one
+// client saturate a multi-GPU server. Each connection is managed on its
+// own, so WithMaxConnectionAge re-dials them independently. A size below
+// two keeps a single connection.
+func WithConnPool(size int) Option {
+	return func(o *options) {
+		o.poolSize = size
+	}
+}
+
+// NewPooledClient connects to the server at url with a pool of poolSize
+// connections. It is shorthand for NewTritonClient with WithConnPool.
+func NewPooledClient(url string, poolSize int, opts ...Option) (*TritonClient, error) {
+	return NewTritonClient(url, append(opts, WithConnPool(poolSize))...)
+}
+
+// connPool hands each call to the next of its connections in turn.
+type connPool struct {
+	conns []*managedConn
+	next  uint32
+}
+
+func newConnPool(size int, newConn func() (*managedConn, error)) (*connPool, error) {
+	if size < 1 {
+		size = 1
+	}
+	p := &connPool{}
+	for i := 0; i < size; i++ {
+		conn, err := newConn()
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+		p.conns = append(p.conns, conn)
+	}
+	return p, nil
+}
+
+func (p *connPool) pick() *managedConn {
+	if len(p.conns) == 1 {
+		return p.conns[0]
+	}
+	n := atomic.AddUint32(&p.next, 1)
+	return p.conns[n%uint32(len(p.conns))]
+}
+
+func (p *connPool) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	return p.pick().Invoke(ctx, method, args, reply, opts...)
+}
+
+func (p *connPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return p.pick().NewStream(ctx, desc, method, opts...)
+}
+
+// state returns the state of the first connection that isn't Ready, or
+// Ready if they all are.
+func (p *connPool) state() connectivity.State {
+	for _, conn := range p.conns {
+		if state := conn.current().GetState(); state != connectivity.Ready {
+			return state
+		}
+	}
+	return connectivity.Ready
+}
+
+// Close closes every connection and returns the first error.
+func (p *connPool) Close() error {
+	var first error
+	for _, conn := range p.conns {
+		if err := conn.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
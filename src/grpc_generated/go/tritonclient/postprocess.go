@@ -0,0 +1,163 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"fmt"
+
+	triton "nvidia_inferenceserver"
+)
+
+// CheckOutputDatatype returns an error unless output index of resp was
+// declared with the given datatype. Typed decoders call it before
+// interpreting raw bytes so a mismatch isn't silently misread.
+func CheckOutputDatatype(resp *triton.ModelInferResponse, index int, datatype string) error {
+	if index < 0 || index >= len(resp.Outputs) {
+		return fmt.Errorf("response has no output %d", index)
+	}
+	output := resp.Outputs[index]
+	if output.Datatype != datatype {
+		return fmt.Errorf("output %s has datatype %s, cannot decode as %s", output.Name, output.Datatype, datatype)
+	}
+	return nil
+}
+
+// RawOutputsByName maps each output name of resp to its raw contents. The
+// server doesn't guarantee outputs come back in the order they were
+// requested, so outputs should be looked up by name rather than position.
+func RawOutputsByName(resp *triton.ModelInferResponse) map[string][]byte {
+	raw := make(map[string][]byte, len(resp.Outputs))
+	for i, output := range resp.Outputs {
+		if i < len(resp.RawOutputContents) {
+			raw[output.Name] = resp.RawOutputContents[i]
+		}
+	}
+	return raw
+}
+
+// PostprocessFloat32 decodes output outputIndex of resp as FP32. The number
+// of elements comes from the output's shape, and the raw contents must
+// hold exactly that many.
+func PostprocessFloat32(resp *triton.ModelInferResponse, outputIndex int) ([]float32, error) {
+	raw, err := shapedRawOutput(resp, outputIndex, "FP32")
+	if err != nil {
+		return nil, err
+	}
+	return DecodeFloat32(raw), nil
+}
+
+// PostprocessFloat16 decodes output outputIndex of resp as FP16, widening
+// each element to float32. Like PostprocessFloat32, the number of elements
+// comes from the output's shape.
+func PostprocessFloat16(resp *triton.ModelInferResponse, outputIndex int) ([]float32, error) {
+	raw, err := shapedRawOutput(resp, outputIndex, "FP16")
+	if err != nil {
+		return nil, err
+	}
+	return DecodeFloat16(raw), nil
+}
+
+// PostprocessInt64 decodes output outputIndex of resp as INT64, the
+// datatype many classification models use for label indices. The number
+// of elements comes from the output's shape.
+func PostprocessInt64(resp *triton.ModelInferResponse, outputIndex int) ([]int64, error) {
+	raw, err := shapedRawOutput(resp, outputIndex, "INT64")
+	if err != nil {
+		return nil, err
+	}
+	return DecodeInt64(raw), nil
+}
+
+// PostprocessUint8 decodes output outputIndex of resp as UINT8, as image
+// models return pixels and masks. The number of elements comes from the
+// output's shape. The returned slice aliases the response's contents.
+func PostprocessUint8(resp *triton.ModelInferResponse, outputIndex int) ([]uint8, error) {
+	return shapedRawOutput(resp, outputIndex, "UINT8")
+}
+
+// shapedRawOutput returns the raw contents of output outputIndex of resp
+// after checking that its datatype is datatype and that the contents hold
+// exactly as many elements as its shape.
+func shapedRawOutput(resp *triton.ModelInferResponse, outputIndex int, datatype string) ([]byte, error) {
+	if err := CheckOutputDatatype(resp, outputIndex, datatype); err != nil {
+		return nil, err
+	}
+	if err := CheckRawOutputShape(resp, outputIndex); err != nil {
+		return nil, err
+	}
+	return resp.RawOutputContents[outputIndex], nil
+}
+
+// CheckRawOutputShape returns an error unless the raw contents of output
+// index of resp hold exactly as many elements of its datatype as its shape
+// calls for. Checking before indexing into the contents turns a response
+// shorter than expected into an error rather than an out of range panic.
+// Outputs of variable-size datatypes such as BYTES are only checked for
+// presence.
+func CheckRawOutputShape(resp *triton.ModelInferResponse, index int) error {
+	if err := CheckRawOutputSize(resp, index); err != nil {
+		return err
+	}
+	output := resp.Outputs[index]
+	size, ok := datatypeSize(output.Datatype)
+	if !ok {
+		return nil
+	}
+	n := int64(1)
+	for _, dim := range output.Shape {
+		n *= dim
+	}
+	raw := resp.RawOutputContents[index]
+	if int64(len(raw)) != int64(size)*n {
+		return fmt.Errorf("output %s has shape %v but %d bytes of contents", output.Name, output.Shape, len(raw))
+	}
+	return nil
+}
+
+// CheckRawOutputSize returns an error unless the raw contents of output
+// index of resp hold a whole number of elements of its datatype. A
+// trailing partial element means the buffer is corrupt, and decoding it
+// would silently drop or misread data.
+func CheckRawOutputSize(resp *triton.ModelInferResponse, index int) error {
+	if index < 0 || index >= len(resp.Outputs) || index >= len(resp.RawOutputContents) {
+		return fmt.Errorf("response has no raw contents for output %d", index)
+	}
+	output := resp.Outputs[index]
+	return checkRawSize(output.Name, output.Datatype, resp.RawOutputContents[index])
+}
+
+func checkRawSize(name, datatype string, raw []byte) error {
+	size, ok := datatypeSize(datatype)
+	if !ok {
+		return nil
+	}
+	if len(raw)%size != 0 {
+		return fmt.Errorf("output %s has %d bytes, not a multiple of the %d-byte %s element size (%d trailing bytes)",
+			name, len(raw), size, datatype, len(raw)%size)
+	}
+	return nil
+}
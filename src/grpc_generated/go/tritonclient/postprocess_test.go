@@ -0,0 +1,182 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestFloat32RoundTrip(t *testing.T) {
+	data := []float32{1.5, -2, 0, 3.25, 1e-3, 42}
+	resp := &triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT0", Datatype: "FP32", Shape: []int64{2, 3}},
+		},
+		RawOutputContents: [][]byte{PreprocessFloat32(data)},
+	}
+
+	got, err := PostprocessFloat32(resp, 0)
+	if err != nil {
+		t.Fatalf("PostprocessFloat32: %v", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("PostprocessFloat32 = %v, want %v", got, data)
+	}
+
+	resp.Outputs[0].Shape = []int64{2, 4}
+	if _, err := PostprocessFloat32(resp, 0); err == nil {
+		t.Error("PostprocessFloat32 accepted contents smaller than the shape")
+	}
+	resp.Outputs[0].Datatype = "INT32"
+	if _, err := PostprocessFloat32(resp, 0); err == nil {
+		t.Error("PostprocessFloat32 accepted an INT32 output")
+	}
+}
+
+func TestFloat16RoundTrip(t *testing.T) {
+	// Every value is exactly representable in half precision.
+	data := []float32{1.5, -2, 0, 65504, float32(math.Pow(2, -24)), float32(math.Inf(1))}
+	resp := &triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT0", Datatype: "FP16", Shape: []int64{2, 3}},
+		},
+		RawOutputContents: [][]byte{EncodeFloat16(data)},
+	}
+
+	got, err := PostprocessFloat16(resp, 0)
+	if err != nil {
+		t.Fatalf("PostprocessFloat16: %v", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("PostprocessFloat16 = %v, want %v", got, data)
+	}
+
+	nan := DecodeFloat16(EncodeFloat16([]float32{float32(math.NaN())}))
+	if !math.IsNaN(float64(nan[0])) {
+		t.Errorf("NaN decoded as %v", nan[0])
+	}
+
+	resp.Outputs[0].Shape = []int64{4}
+	if _, err := PostprocessFloat16(resp, 0); err == nil {
+		t.Error("PostprocessFloat16 accepted contents larger than the shape")
+	}
+}
+
+func TestPostprocessInt64(t *testing.T) {
+	data := []int64{7, -1, 1 << 40}
+	raw, err := EncodeTensor("INT64", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := &triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "LABELS", Datatype: "INT64", Shape: []int64{1, 3}},
+		},
+		RawOutputContents: [][]byte{raw},
+	}
+
+	got, err := PostprocessInt64(resp, 0)
+	if err != nil {
+		t.Fatalf("PostprocessInt64: %v", err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("PostprocessInt64 = %v, want %v", got, data)
+	}
+
+	resp.Outputs[0].Shape = []int64{1, 6}
+	if _, err := PostprocessInt64(resp, 0); err == nil {
+		t.Error("PostprocessInt64 accepted contents smaller than the shape")
+	}
+}
+
+func TestCheckOutputDatatype(t *testing.T) {
+	resp := &triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT0", Datatype: "INT32"},
+			{Name: "OUTPUT1", Datatype: "FP32"},
+		},
+	}
+
+	if err := CheckOutputDatatype(resp, 0, "INT32"); err != nil {
+		t.Errorf("CheckOutputDatatype of a matching output: %v", err)
+	}
+	err := CheckOutputDatatype(resp, 1, "INT32")
+	if err == nil || !strings.Contains(err.Error(), "OUTPUT1 has datatype FP32") {
+		t.Errorf("CheckOutputDatatype of an FP32 output as INT32: %v", err)
+	}
+	for _, index := range []int{-1, 2} {
+		if err := CheckOutputDatatype(resp, index, "INT32"); err == nil {
+			t.Errorf("CheckOutputDatatype accepted out-of-range index %d", index)
+		}
+	}
+}
+
+func TestRawOutputsByName(t *testing.T) {
+	raw := RawOutputsByName(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT1"},
+			{Name: "OUTPUT0"},
+			{Name: "OUTPUT2"},
+		},
+		RawOutputContents: [][]byte{{1}, {0}},
+	})
+
+	want := map[string][]byte{"OUTPUT0": {0}, "OUTPUT1": {1}}
+	if !reflect.DeepEqual(raw, want) {
+		t.Errorf("RawOutputsByName = %v, want %v", raw, want)
+	}
+}
+
+func TestCheckRawOutputShape(t *testing.T) {
+	resp := &triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT0", Datatype: "INT32", Shape: []int64{1, 2}},
+			{Name: "OUTPUT1", Datatype: "INT32", Shape: []int64{1, 2}},
+			{Name: "LABELS", Datatype: "BYTES", Shape: []int64{1}},
+		},
+		RawOutputContents: [][]byte{make([]byte, 8), make([]byte, 4), {1, 0, 0, 0, 'a'}},
+	}
+
+	if err := CheckRawOutputShape(resp, 0); err != nil {
+		t.Errorf("CheckRawOutputShape of a full buffer: %v", err)
+	}
+	if err := CheckRawOutputShape(resp, 1); err == nil {
+		t.Error("CheckRawOutputShape accepted a buffer shorter than the shape")
+	}
+	if err := CheckRawOutputShape(resp, 2); err != nil {
+		t.Errorf("CheckRawOutputShape of a BYTES output: %v", err)
+	}
+	resp.RawOutputContents = resp.RawOutputContents[:1]
+	if err := CheckRawOutputShape(resp, 1); err == nil {
+		t.Error("CheckRawOutputShape accepted an output with no raw contents")
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Preprocess converts string input data into raw BYTES tensor contents
+// (assumes Little Endian). Each string is framed by its 4-byte length.
+// batchSize must equal len(inputStrList), one string per batch element.
+func Preprocess(inputStrList []string, batchSize int) ([]byte, error) {
+	return PreprocessWithByteOrder(inputStrList, batchSize, binary.LittleEndian)
+}
+
+// PreprocessWithByteOrder is Preprocess writing the length prefixes in
+// order. The server only accepts little-endian contents; other orders are
+// for pipelines that store or convert the data before it is sent, and
+// must be decoded with DecodeBytesWithByteOrder using the same order.
+func PreprocessWithByteOrder(inputStrList []string, batchSize int, order binary.ByteOrder) ([]byte, error) {
+	if batchSize != len(inputStrList) {
+		return nil, fmt.Errorf("batch size %d doesn't match the %d input strings", batchSize, len(inputStrList))
+	}
+	var inputStrBytes []byte
+	for _, inputStr := range inputStrList {
+		inputStrBytes = appendBytesElement(inputStrBytes, inputStr, order)
+	}
+	return inputStrBytes, nil
+}
+
+// PreprocessFloat32 converts FP32 input data into raw little-endian tensor
+// contents.
+func PreprocessFloat32(data []float32) []byte {
+	raw := make([]byte, 4*len(data))
+	for i, v := range data {
+		binary.LittleEndian.PutUint32(raw[i*4:], math.Float32bits(v))
+	}
+	return raw
+}
+
+// PreprocessBatch converts a batch of string elements into raw BYTES tensor
+// contents, where batch[b] holds the strings of batch element b. Every
+// element must hold the same number of strings; the returned shape is
+// [len(batch), len(batch[0])].
+func PreprocessBatch(batch [][]string) ([]byte, []int64, error) {
+	if len(batch) == 0 {
+		return nil, nil, errors.New("batch is empty")
+	}
+	width := len(batch[0])
+	var inputStrBytes []byte
+	for b, element := range batch {
+		if len(element) != width {
+			return nil, nil, fmt.Errorf("batch element %d has %d strings, expected %d", b, len(element), width)
+		}
+		for _, inputStr := range element {
+			inputStrBytes = appendBytesElement(inputStrBytes, inputStr, binary.LittleEndian)
+		}
+	}
+	return inputStrBytes, []int64{int64(len(batch)), int64(width)}, nil
+}
+
+// appendBytesElement appends one BYTES element to dst, prefixed by its
+// length in order.
+func appendBytesElement(dst []byte, s string, order binary.ByteOrder) []byte {
+	var prefix [4]byte
+	order.PutUint32(prefix[:], uint32(len(s)))
+	dst = append(dst, prefix[:]...)
+	return append(dst, s...)
+}
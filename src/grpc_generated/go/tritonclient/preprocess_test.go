@@ -0,0 +1,144 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPreprocess(t *testing.T) {
+	raw, err := Preprocess([]string{"a", "bc"}, 2)
+	if err != nil {
+		t.Fatalf("Preprocess: %v", err)
+	}
+	if got := string(raw); got != "\x01\x00\x00\x00a\x02\x00\x00\x00bc" {
+		t.Errorf("Preprocess = %q", got)
+	}
+
+	for _, batchSize := range []int{1, 3} {
+		if _, err := Preprocess([]string{"a", "bc"}, batchSize); err == nil {
+			t.Errorf("Preprocess of 2 strings with batch size %d succeeded", batchSize)
+		}
+	}
+}
+
+func TestPreprocessBatch(t *testing.T) {
+	raw, shape, err := PreprocessBatch([][]string{{"a", "b"}, {"c", "d"}})
+	if err != nil {
+		t.Fatalf("PreprocessBatch: %v", err)
+	}
+	if len(shape) != 2 || shape[0] != 2 || shape[1] != 2 {
+		t.Errorf("shape = %v, want [2 2]", shape)
+	}
+	if len(raw) != 4*5 {
+		t.Errorf("got %d bytes, want 20", len(raw))
+	}
+
+	if _, _, err := PreprocessBatch([][]string{{"a", "b"}, {"c"}}); err == nil {
+		t.Error("PreprocessBatch of a ragged batch succeeded")
+	}
+}
+
+func TestPreprocessWithByteOrder(t *testing.T) {
+	raw, err := PreprocessWithByteOrder([]string{"ab", "c"}, 2, binary.BigEndian)
+	if err != nil {
+		t.Fatalf("PreprocessWithByteOrder: %v", err)
+	}
+	if got := string(raw); got != "\x00\x00\x00\x02ab\x00\x00\x00\x01c" {
+		t.Errorf("PreprocessWithByteOrder = %q", got)
+	}
+
+	values, err := DecodeBytesWithByteOrder(raw, binary.BigEndian)
+	if err != nil {
+		t.Fatalf("DecodeBytesWithByteOrder: %v", err)
+	}
+	if len(values) != 2 || values[0] != "ab" || values[1] != "c" {
+		t.Errorf("DecodeBytesWithByteOrder = %q", values)
+	}
+	if _, err := DecodeBytes(raw); err == nil {
+		t.Error("DecodeBytes read big-endian prefixes as little-endian without error")
+	}
+}
+
+func TestDecodeBytesOutput(t *testing.T) {
+	labels := []string{"", "chat", "猫", "café 😀"}
+	raw, err := Preprocess(labels, len(labels))
+	if err != nil {
+		t.Fatalf("Preprocess: %v", err)
+	}
+	got, err := DecodeBytesOutput(raw, len(labels))
+	if err != nil {
+		t.Fatalf("DecodeBytesOutput: %v", err)
+	}
+	if !reflect.DeepEqual(got, labels) {
+		t.Errorf("DecodeBytesOutput = %q, want %q", got, labels)
+	}
+	if _, err := DecodeBytesOutput(raw, len(labels)+1); err == nil {
+		t.Error("DecodeBytesOutput accepted a count that disagrees with the contents")
+	}
+	if _, err := DecodeBytesOutput(raw[:len(raw)-1], len(labels)); err == nil {
+		t.Error("DecodeBytesOutput accepted a truncated element")
+	}
+}
+
+func TestPreprocessRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		batch [][]string
+	}{
+		{"single", [][]string{{"test"}}},
+		{"empty strings", [][]string{{"", ""}, {"a", ""}}},
+		{"multi-byte UTF-8", [][]string{{"héllo", "日本語"}, {"🙂", "ñ"}}},
+		{"batch of three", [][]string{{"1"}, {"22"}, {"333"}}},
+		{"embedded NUL and long", [][]string{{"a\x00b", strings.Repeat("x", 1<<16)}}},
+	}
+	for _, tt := range tests {
+		raw, shape, err := PreprocessBatch(tt.batch)
+		if err != nil {
+			t.Errorf("%s: PreprocessBatch: %v", tt.name, err)
+			continue
+		}
+		if shape[0] != int64(len(tt.batch)) || shape[1] != int64(len(tt.batch[0])) {
+			t.Errorf("%s: shape = %v", tt.name, shape)
+		}
+		var want []string
+		for _, row := range tt.batch {
+			want = append(want, row...)
+		}
+		got, err := DecodeBytesOutput(raw, len(want))
+		if err != nil {
+			t.Errorf("%s: DecodeBytesOutput: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: round trip = %q, want %q", tt.name, got, want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// EncodeFromReaderInto reads fixed-size elements of datatype from r,
+// stored in the given byte order, into dst, such as a mapped shared-memory
+// region or a buffer sized from the tensor's shape, and converts them in
+// place to little-endian raw tensor contents. The data is never held in
+// memory twice. It returns the number of bytes written and fails if r
+// holds more data than fits in dst; checking for that reads, and so
+// consumes, one more byte from r once dst is full.
+func EncodeFromReaderInto(dst []byte, r io.Reader, datatype string, order binary.ByteOrder) (int, error) {
+	size, ok := datatypeSize(datatype)
+	if !ok {
+		return 0, fmt.Errorf("cannot stream-encode datatype %s", datatype)
+	}
+	n, err := io.ReadFull(r, dst)
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+	case err != nil:
+		return n, err
+	default:
+		var extra [1]byte
+		switch _, err := io.ReadFull(r, extra[:]); err {
+		case io.EOF:
+		case nil:
+			return n, errors.New("input is larger than the destination buffer")
+		default:
+			return n, err
+		}
+	}
+	if err := toLittleEndian(dst[:n], size, order); err != nil {
+		return n, err
+	}
+	return n, nil
+}
+
+// toLittleEndian checks that raw holds whole elements of size bytes and
+// byte-swaps them in place if order is not little-endian.
+func toLittleEndian(raw []byte, size int, order binary.ByteOrder) error {
+	if len(raw)%size != 0 {
+		return fmt.Errorf("read %d bytes, not a whole number of %d-byte elements", len(raw), size)
+	}
+	if size == 1 || order.Uint16([]byte{1, 0}) == 1 {
+		return nil
+	}
+	for i := 0; i < len(raw); i += size {
+		element := raw[i : i+size]
+		for a, b := 0, size-1; a < b; a, b = a+1, b-1 {
+			element[a], element[b] = element[b], element[a]
+		}
+	}
+	return nil
+}
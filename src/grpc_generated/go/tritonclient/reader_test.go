@@ -0,0 +1,93 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestEncodeFromReaderIntoBigEndian(t *testing.T) {
+	values := []float32{1.5, -2, 3.25}
+	var src bytes.Buffer
+	binary.Write(&src, binary.BigEndian, values)
+
+	raw := make([]byte, 4*len(values))
+	if _, err := EncodeFromReaderInto(raw, &src, "FP32", binary.BigEndian); err != nil {
+		t.Fatalf("EncodeFromReaderInto: %v", err)
+	}
+	for i, v := range values {
+		if got := math.Float32frombits(binary.LittleEndian.Uint32(raw[i*4:])); got != v {
+			t.Errorf("element %d = %v, want %v", i, got, v)
+		}
+	}
+}
+
+// stallingReader returns (0, nil) once before each read of r.
+type stallingReader struct {
+	r       io.Reader
+	stalled bool
+}
+
+func (s *stallingReader) Read(p []byte) (int, error) {
+	if !s.stalled {
+		s.stalled = true
+		return 0, nil
+	}
+	s.stalled = false
+	return s.r.Read(p)
+}
+
+func TestEncodeFromReaderInto(t *testing.T) {
+	src := []byte{1, 0, 0, 0, 2, 0, 0, 0}
+
+	dst := make([]byte, 16)
+	n, err := EncodeFromReaderInto(dst, bytes.NewReader(src), "INT32", binary.LittleEndian)
+	if err != nil {
+		t.Fatalf("EncodeFromReaderInto: %v", err)
+	}
+	if n != len(src) || !bytes.Equal(dst[:n], src) {
+		t.Errorf("wrote %v, want %v", dst[:n], src)
+	}
+
+	if _, err := EncodeFromReaderInto(make([]byte, 4), bytes.NewReader(src), "INT32", binary.LittleEndian); err == nil {
+		t.Error("EncodeFromReaderInto into a short buffer succeeded")
+	}
+	// Extra data is noticed even behind a read that returns nothing.
+	if _, err := EncodeFromReaderInto(make([]byte, 4), &stallingReader{r: bytes.NewReader(src)}, "INT32", binary.LittleEndian); err == nil {
+		t.Error("EncodeFromReaderInto into a short buffer from a stalling reader succeeded")
+	}
+	if _, err := EncodeFromReaderInto(dst, bytes.NewReader(src[:6]), "INT32", binary.LittleEndian); err == nil {
+		t.Error("EncodeFromReaderInto with a partial element succeeded")
+	}
+	if _, err := EncodeFromReaderInto(dst, bytes.NewReader(src), "BYTES", binary.LittleEndian); err == nil {
+		t.Error("EncodeFromReaderInto for BYTES succeeded")
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxReadyPollInterval caps the backoff between readiness checks.
+const maxReadyPollInterval = 5 * time.Second
+
+// WaitForServerReady polls ServerReady until the server reports ready or
+// ctx is done. The first retry comes after pollInterval, which must be
+// positive, and the interval doubles after each attempt, up to five
+// seconds. Unavailable errors, as seen while the server is still
+// starting, are retried; any other error is returned at once. The error
+// returned when ctx is done reports how many attempts were made.
+func (c *TritonClient) WaitForServerReady(ctx context.Context, pollInterval time.Duration) error {
+	if pollInterval <= 0 {
+		return fmt.Errorf("poll interval must be positive, got %v", pollInterval)
+	}
+	maxInterval := maxReadyPollInterval
+	if pollInterval > maxInterval {
+		maxInterval = pollInterval
+	}
+
+	interval := pollInterval
+	var lastErr error
+	for attempts := 1; ; attempts++ {
+		ready, err := c.Ready(ctx)
+		if err == nil && ready {
+			return nil
+		}
+		if err != nil && ctx.Err() == nil && status.Code(err) != codes.Unavailable {
+			return err
+		}
+		lastErr = err
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if lastErr != nil {
+				return fmt.Errorf("server not ready after %d attempts: %w (last error: %v)", attempts, ctx.Err(), lastErr)
+			}
+			return fmt.Errorf("server not ready after %d attempts: %w", attempts, ctx.Err())
+		case <-timer.C:
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// WaitUntilReady is WaitForServerReady giving up after maxWait. Checks
+// back off from pollInterval, which must be positive, doubling up to five
+// seconds apart rather than coming every pollInterval. Only Unavailable
+// errors are retried; any other error ends the wait early and is
+// returned.
+func (c *TritonClient) WaitUntilReady(ctx context.Context, pollInterval, maxWait time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, maxWait)
+	defer cancel()
+	return c.WaitForServerReady(ctx, pollInterval)
+}
+
+// Health is the outcome of HealthCheck.
+type Health struct {
+	Live  bool
+	Ready bool
+	// Err is the error from whichever call failed, if any.
+	Err error
+}
+
+// Healthy reports whether the server is both live and ready.
+func (h Health) Healthy() bool {
+	return h.Live && h.Ready && h.Err == nil
+}
+
+// HealthCheck calls ServerLive and then, if the server is live,
+// ServerReady, combining the results for use in readiness probes. A
+// server that isn't live is reported not ready without asking.
+func (c *TritonClient) HealthCheck(ctx context.Context) Health {
+	var h Health
+	h.Live, h.Err = c.Live(ctx)
+	if h.Err != nil || !h.Live {
+		return h
+	}
+	h.Ready, h.Err = c.Ready(ctx)
+	return h
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWaitForServerReady(t *testing.T) {
+	var calls int32
+	client := startFakeServer(t, &fakeServer{
+		serverReady: func(context.Context, *triton.ServerReadyRequest) (*triton.ServerReadyResponse, error) {
+			switch atomic.AddInt32(&calls, 1) {
+			case 1, 2:
+				return nil, status.Error(codes.Unavailable, "starting")
+			case 3:
+				return &triton.ServerReadyResponse{Ready: false}, nil
+			}
+			return &triton.ServerReadyResponse{Ready: true}, nil
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.WaitForServerReady(ctx, time.Millisecond); err != nil {
+		t.Fatalf("WaitForServerReady: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 4 {
+		t.Errorf("server saw %d calls, want 4", got)
+	}
+}
+
+func TestWaitForServerReadyErrors(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		serverReady: func(context.Context, *triton.ServerReadyRequest) (*triton.ServerReadyResponse, error) {
+			return nil, status.Error(codes.PermissionDenied, "no")
+		},
+	})
+	if err := client.WaitForServerReady(context.Background(), time.Millisecond); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("WaitForServerReady: %v, want PermissionDenied", err)
+	}
+
+	notReady := startFakeServer(t, &fakeServer{
+		serverReady: func(context.Context, *triton.ServerReadyRequest) (*triton.ServerReadyResponse, error) {
+			return &triton.ServerReadyResponse{Ready: false}, nil
+		},
+	})
+	err := notReady.WaitUntilReady(context.Background(), time.Millisecond, 20*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitUntilReady: %v, want a deadline error", err)
+	}
+
+	// A zero interval is rejected up front rather than polling back to
+	// back until the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := notReady.WaitForServerReady(ctx, 0); err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitForServerReady with a zero poll interval: %v, want an immediate error", err)
+	}
+}
+
+func TestWaitUntilReady(t *testing.T) {
+	var ready int32
+	client := startFakeServer(t, &fakeServer{
+		serverReady: func(context.Context, *triton.ServerReadyRequest) (*triton.ServerReadyResponse, error) {
+			return &triton.ServerReadyResponse{Ready: atomic.LoadInt32(&ready) == 1}, nil
+		},
+	})
+
+	start := time.Now()
+	err := client.WaitUntilReady(context.Background(), time.Millisecond, 30*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitUntilReady of a server that never gets ready: %v, want a deadline error", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("WaitUntilReady gave up after %v, want about 30ms", elapsed)
+	}
+
+	time.AfterFunc(20*time.Millisecond, func() { atomic.StoreInt32(&ready, 1) })
+	if err := client.WaitUntilReady(context.Background(), time.Millisecond, 5*time.Second); err != nil {
+		t.Errorf("WaitUntilReady of a server that gets ready: %v", err)
+	}
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if err := client.WaitUntilReady(context.Background(), interval, time.Second); err == nil {
+			t.Errorf("WaitUntilReady with poll interval %v succeeded", interval)
+		}
+	}
+}
+
+func TestHealthCheck(t *testing.T) {
+	var live bool
+	var readyCalls int
+	readyErr := error(nil)
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			return &triton.ServerLiveResponse{Live: live}, nil
+		},
+		serverReady: func(context.Context, *triton.ServerReadyRequest) (*triton.ServerReadyResponse, error) {
+			readyCalls++
+			return &triton.ServerReadyResponse{Ready: true}, readyErr
+		},
+	})
+	ctx := context.Background()
+
+	if h := client.HealthCheck(ctx); h.Live || h.Ready || h.Err != nil || readyCalls != 0 {
+		t.Errorf("not live: HealthCheck = %+v after %d ServerReady calls", h, readyCalls)
+	}
+	live = true
+	if h := client.HealthCheck(ctx); !h.Healthy() {
+		t.Errorf("live and ready: HealthCheck = %+v", h)
+	}
+	readyErr = status.Error(codes.Internal, "boom")
+	if h := client.HealthCheck(ctx); h.Healthy() || !h.Live || status.Code(h.Err) != codes.Internal {
+		t.Errorf("ready fails: HealthCheck = %+v", h)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+
+	triton "nvidia_inferenceserver"
+)
+
+// Index lists the models in the server's repositories with their version
+// and state. With readyOnly, only models ready for inferencing are listed.
+func (c *TritonClient) Index(ctx context.Context, readyOnly bool) ([]*triton.RepositoryIndexResponse_ModelIndex, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	resp, err := c.client.RepositoryIndex(ctx, &triton.RepositoryIndexRequest{Ready: readyOnly})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Models, nil
+}
+
+// LoadModel asks a server in explicit model-control mode to load, or
+// reload, the named model from its repository.
+func (c *TritonClient) LoadModel(ctx context.Context, name string) error {
+	return c.loadModel(ctx, &triton.RepositoryModelLoadRequest{ModelName: name})
+}
+
+// LoadModelWithConfig is LoadModel using config, a model configuration in
+// JSON, in place of the config.pbtxt in the repository.
+func (c *TritonClient) LoadModelWithConfig(ctx context.Context, name string, config []byte) error {
+	return c.loadModel(ctx, &triton.RepositoryModelLoadRequest{
+		ModelName: name,
+		Parameters: map[string]*triton.ModelRepositoryParameter{
+			"config": {ParameterChoice: &triton.ModelRepositoryParameter_StringParam{StringParam: string(config)}},
+		},
+	})
+}
+
+func (c *TritonClient) loadModel(ctx context.Context, req *triton.RepositoryModelLoadRequest) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	_, err := c.client.RepositoryModelLoad(ctx, req)
+	return err
+}
+
+// UnloadModel asks a server in explicit model-control mode to unload the
+// named model.
+func (c *TritonClient) UnloadModel(ctx context.Context, name string) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	_, err := c.client.RepositoryModelUnload(ctx, &triton.RepositoryModelUnloadRequest{ModelName: name})
+	return err
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadAndUnloadModel(t *testing.T) {
+	var loads []*triton.RepositoryModelLoadRequest
+	var unloaded string
+	client := startFakeServer(t, &fakeServer{
+		modelLoad: func(_ context.Context, req *triton.RepositoryModelLoadRequest) (*triton.RepositoryModelLoadResponse, error) {
+			if req.ModelName == "missing" {
+				return nil, status.Error(codes.InvalidArgument, "no such model")
+			}
+			loads = append(loads, req)
+			return &triton.RepositoryModelLoadResponse{}, nil
+		},
+		modelUnload: func(_ context.Context, req *triton.RepositoryModelUnloadRequest) (*triton.RepositoryModelUnloadResponse, error) {
+			unloaded = req.ModelName
+			return &triton.RepositoryModelUnloadResponse{}, nil
+		},
+	})
+	ctx := context.Background()
+
+	if err := client.LoadModel(ctx, "simple"); err != nil {
+		t.Fatalf("LoadModel: %v", err)
+	}
+	config := []byte(`{"max_batch_size": 8}`)
+	if err := client.LoadModelWithConfig(ctx, "simple", config); err != nil {
+		t.Fatalf("LoadModelWithConfig: %v", err)
+	}
+	if len(loads) != 2 {
+		t.Fatalf("server saw %d loads, want 2", len(loads))
+	}
+	if len(loads[0].Parameters) != 0 {
+		t.Errorf("LoadModel sent parameters %v", loads[0].Parameters)
+	}
+	if got := loads[1].Parameters["config"].GetStringParam(); got != string(config) {
+		t.Errorf("config parameter = %q, want %q", got, config)
+	}
+
+	if err := client.LoadModel(ctx, "missing"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("LoadModel(missing): %v, want InvalidArgument", err)
+	}
+
+	if err := client.UnloadModel(ctx, "simple"); err != nil {
+		t.Fatalf("UnloadModel: %v", err)
+	}
+	if unloaded != "simple" {
+		t.Errorf("unloaded %q, want simple", unloaded)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	models := []*triton.RepositoryIndexResponse_ModelIndex{
+		{Name: "simple", Version: "1", State: "READY"},
+		{Name: "ensemble", State: "UNAVAILABLE", Reason: "unloaded"},
+	}
+	client := startFakeServer(t, &fakeServer{
+		repositoryIndex: func(_ context.Context, req *triton.RepositoryIndexRequest) (*triton.RepositoryIndexResponse, error) {
+			if req.Ready {
+				return &triton.RepositoryIndexResponse{Models: models[:1]}, nil
+			}
+			return &triton.RepositoryIndexResponse{Models: models}, nil
+		},
+	})
+
+	all, err := client.Index(context.Background(), false)
+	if err != nil {
+		t.Fatalf("Index: %v", err)
+	}
+	if len(all) != 2 || all[1].Name != "ensemble" || all[1].Reason != "unloaded" {
+		t.Errorf("Index(false) = %v", all)
+	}
+	ready, err := client.Index(context.Background(), true)
+	if err != nil {
+		t.Fatalf("Index: %v", err)
+	}
+	if len(ready) != 1 || ready[0].Name != "simple" {
+		t.Errorf("Index(true) = %v", ready)
+	}
+}
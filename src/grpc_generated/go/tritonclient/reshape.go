@@ -0,0 +1,80 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Reshape returns flat, a decoded slice such as []float32, as nested
+// slices following shape, so that an NCHW output of shape [1 3 2 2] comes
+// back as a [][][][]float32. The nested slices share flat's backing
+// array. flat must hold exactly as many elements as shape; a rank 0 shape
+// returns flat unchanged.
+func Reshape(flat interface{}, shape []int64) (interface{}, error) {
+	v := reflect.ValueOf(flat)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("cannot reshape %T, not a slice", flat)
+	}
+	n := int64(1)
+	for _, dim := range shape {
+		if dim < 0 {
+			return nil, fmt.Errorf("cannot reshape into shape %v", shape)
+		}
+		n *= dim
+	}
+	if int64(v.Len()) != n {
+		return nil, fmt.Errorf("cannot reshape %d elements into shape %v, which holds %d", v.Len(), shape, n)
+	}
+	if len(shape) == 0 {
+		return flat, nil
+	}
+	return reshape(v, shape).Interface(), nil
+}
+
+// reshape nests v, which holds exactly product(shape) elements, into
+// len(shape) levels of slices.
+func reshape(v reflect.Value, shape []int64) reflect.Value {
+	if len(shape) == 1 {
+		return v
+	}
+	t := v.Type()
+	for range shape[1:] {
+		t = reflect.SliceOf(t)
+	}
+	outer := int(shape[0])
+	out := reflect.MakeSlice(t, outer, outer)
+	if outer == 0 {
+		return out
+	}
+	inner := v.Len() / outer
+	for i := 0; i < outer; i++ {
+		out.Index(i).Set(reshape(v.Slice3(i*inner, (i+1)*inner, (i+1)*inner), shape[1:]))
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"reflect"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestReshape(t *testing.T) {
+	flat := []float32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	tests := []struct {
+		shape []int64
+		want  interface{}
+	}{
+		{[]int64{12}, flat},
+		{[]int64{3, 4}, [][]float32{{0, 1, 2, 3}, {4, 5, 6, 7}, {8, 9, 10, 11}}},
+		{[]int64{1, 3, 2, 2}, [][][][]float32{{{{0, 1}, {2, 3}}, {{4, 5}, {6, 7}}, {{8, 9}, {10, 11}}}}},
+	}
+	for _, tt := range tests {
+		got, err := Reshape(flat, tt.shape)
+		if err != nil {
+			t.Errorf("Reshape(%v): %v", tt.shape, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Reshape(%v) = %v, want %v", tt.shape, got, tt.want)
+		}
+	}
+
+	if got, err := Reshape([]int32{}, []int64{0, 3}); err != nil || len(got.([][]int32)) != 0 {
+		t.Errorf("Reshape of an empty tensor = %v, %v", got, err)
+	}
+	for _, shape := range [][]int64{{5}, {2, -1}} {
+		if _, err := Reshape(flat, shape); err == nil {
+			t.Errorf("Reshape(%v) of 12 elements succeeded", shape)
+		}
+	}
+	if _, err := Reshape(7, []int64{1}); err == nil {
+		t.Error("Reshape of a non-slice succeeded")
+	}
+}
+
+func TestInferResultReshaped(t *testing.T) {
+	result := NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT0", Datatype: "INT32", Shape: []int64{2, 2}},
+			{Name: "OUTPUT1", Datatype: "INT32", Shape: []int64{2, 3}},
+		},
+		RawOutputContents: [][]byte{
+			{1, 0, 0, 0, 2, 0, 0, 0, 3, 0, 0, 0, 4, 0, 0, 0},
+			{1, 0, 0, 0},
+		},
+	})
+
+	got, err := result.Reshaped("OUTPUT0")
+	if err != nil {
+		t.Fatalf("Reshaped: %v", err)
+	}
+	if want := [][]int32{{1, 2}, {3, 4}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Reshaped = %v, want %v", got, want)
+	}
+	if _, err := result.Reshaped("OUTPUT1"); err == nil {
+		t.Error("Reshaped of contents smaller than the shape succeeded")
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// InferenceClient is the set of calls both transports support, so code
+// can be written once against either TritonClient (gRPC) or RESTClient
+// (HTTP).
+type InferenceClient interface {
+	Live(ctx context.Context) (bool, error)
+	Ready(ctx context.Context) (bool, error)
+	ModelMetadata(ctx context.Context, name, version string) (*triton.ModelMetadataResponse, error)
+	Infer(ctx context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error)
+}
+
+var (
+	_ InferenceClient = (*TritonClient)(nil)
+	_ InferenceClient = (*RESTClient)(nil)
+)
+
+// inferHeaderLength is the HTTP header giving the length of the JSON
+// header that precedes the binary tensor data in a request or response.
+const inferHeaderLength = "Inference-Header-Content-Length"
+
+// RESTClient talks to Triton's HTTP/REST endpoint (the KServe v2
+// protocol, port 8000 by default). Tensors are sent and received with the
+// binary data extension, so requests and responses carry the same raw
+// contents as over gRPC.
+type RESTClient struct {
+	base    string
+	http    *http.Client
+	timeout time.Duration
+}
+
+// NewRESTClient returns a client for the server at rawURL, such as
+// "localhost:8000" or "https://triton.example.com". httpClient may be nil
+// to use http.DefaultClient. Calls whose context has no deadline get
+// DefaultTimeout.
+func NewRESTClient(rawURL string, httpClient *http.Client) (*RESTClient, error) {
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %s: %w", rawURL, err)
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &RESTClient{base: strings.TrimSuffix(u.String(), "/"), http: httpClient, timeout: DefaultTimeout}, nil
+}
+
+// Live reports whether the server is live.
+func (c *RESTClient) Live(ctx context.Context) (bool, error) {
+	return c.health(ctx, "/v2/health/live")
+}
+
+// Ready reports whether the server is ready for inference.
+func (c *RESTClient) Ready(ctx context.Context) (bool, error) {
+	return c.health(ctx, "/v2/health/ready")
+}
+
+// health reports whether GET path succeeds. Any HTTP status other than
+// 200 means the server isn't healthy; only transport failures are errors.
+func (c *RESTClient) health(ctx context.Context, path string) (bool, error) {
+	resp, err := c.do(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode == http.StatusOK, nil
+}
+
+// ModelMetadata returns the metadata of the named model. An empty version
+// selects the version the server considers latest.
+func (c *RESTClient) ModelMetadata(ctx context.Context, name, version string) (*triton.ModelMetadataResponse, error) {
+	version, err := normalizeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	body, _, err := c.call(ctx, http.MethodGet, modelPath(name, version), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	metadata := &triton.ModelMetadataResponse{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, metadata); err != nil {
+		return nil, fmt.Errorf("couldn't parse model metadata: %w", err)
+	}
+	return metadata, nil
+}
+
+// restTensor describes an input or output in the JSON header of an
+// inference request or response.
+type restTensor struct {
+	Name       string                 `json:"name"`
+	Shape      []int64                `json:"shape,omitempty"`
+	Datatype   string                 `json:"datatype,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+type restInferRequest struct {
+	ID         string                 `json:"id,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Inputs     []restTensor           `json:"inputs"`
+	Outputs    []restTensor           `json:"outputs,omitempty"`
+}
+
+type restInferResponse struct {
+	ModelName    string                 `json:"model_name"`
+	ModelVersion string                 `json:"model_version"`
+	ID           string                 `json:"id"`
+	Parameters   map[string]interface{} `json:"parameters"`
+	Outputs      []restTensor           `json:"outputs"`
+}
+
+// Infer sends req, which must carry its inputs as raw contents, and
+// returns the response with every output as raw contents, just as the
+// gRPC client would.
+func (c *RESTClient) Infer(ctx context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+	if err := ValidateRawInputContents(req); err != nil {
+		return nil, err
+	}
+	version, err := normalizeVersion(req.ModelVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	header := restInferRequest{
+		ID:         req.Id,
+		Parameters: restParameters(req.Parameters),
+	}
+	if header.Parameters == nil {
+		header.Parameters = make(map[string]interface{})
+	}
+	header.Parameters["binary_data_output"] = true
+	var binary [][]byte
+	raw := req.RawInputContents
+	for _, input := range req.Inputs {
+		tensor := restTensor{
+			Name:       input.Name,
+			Shape:      input.Shape,
+			Datatype:   input.Datatype,
+			Parameters: restParameters(input.Parameters),
+		}
+		if _, ok := input.Parameters["shared_memory_region"]; !ok {
+			if len(raw) == 0 {
+				return nil, fmt.Errorf("input %s has no raw contents; the REST client sends only raw contents", input.Name)
+			}
+			if tensor.Parameters == nil {
+				tensor.Parameters = make(map[string]interface{})
+			}
+			tensor.Parameters["binary_data_size"] = len(raw[0])
+			binary = append(binary, raw[0])
+			raw = raw[1:]
+		}
+		header.Inputs = append(header.Inputs, tensor)
+	}
+	for _, output := range req.Outputs {
+		tensor := restTensor{Name: output.Name, Parameters: restParameters(output.Parameters)}
+		if tensor.Parameters == nil {
+			tensor.Parameters = make(map[string]interface{})
+		}
+		tensor.Parameters["binary_data"] = true
+		header.Outputs = append(header.Outputs, tensor)
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	body := bytes.NewBuffer(headerJSON)
+	for _, b := range binary {
+		body.Write(b)
+	}
+	headers := http.Header{
+		"Content-Type":    {"application/octet-stream"},
+		inferHeaderLength: {strconv.Itoa(len(headerJSON))},
+	}
+
+	respBody, respHeaders, err := c.call(ctx, http.MethodPost, modelPath(req.ModelName, version)+"/infer", headers, body)
+	if err != nil {
+		return nil, err
+	}
+	return parseRESTInferResponse(respBody, respHeaders)
+}
+
+// parseRESTInferResponse splits an inference response into its JSON
+// header and the binary contents of each output.
+func parseRESTInferResponse(body []byte, headers http.Header) (*triton.ModelInferResponse, error) {
+	headerJSON, binary := body, []byte(nil)
+	if v := headers.Get(inferHeaderLength); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > len(body) {
+			return nil, fmt.Errorf("invalid %s %q for a %d-byte response", inferHeaderLength, v, len(body))
+		}
+		headerJSON, binary = body[:n], body[n:]
+	}
+	var header restInferResponse
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, fmt.Errorf("couldn't parse inference response: %w", err)
+	}
+
+	resp := &triton.ModelInferResponse{
+		ModelName:    header.ModelName,
+		ModelVersion: header.ModelVersion,
+		Id:           header.ID,
+		Parameters:   inferParameters(header.Parameters),
+	}
+	for _, output := range header.Outputs {
+		size, ok := output.Parameters["binary_data_size"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("output %s was not returned as binary data", output.Name)
+		}
+		if int(size) > len(binary) {
+			return nil, fmt.Errorf("output %s has %d bytes of binary data but only %d remain", output.Name, int(size), len(binary))
+		}
+		delete(output.Parameters, "binary_data_size")
+		resp.Outputs = append(resp.Outputs, &triton.ModelInferResponse_InferOutputTensor{
+			Name:       output.Name,
+			Datatype:   output.Datatype,
+			Shape:      output.Shape,
+			Parameters: inferParameters(output.Parameters),
+		})
+		resp.RawOutputContents = append(resp.RawOutputContents, binary[:int(size)])
+		binary = binary[int(size):]
+	}
+	return resp, nil
+}
+
+// restParameters converts gRPC parameters to their JSON values.
+func restParameters(params map[string]*triton.InferParameter) map[string]interface{} {
+	if len(params) == 0 {
+		return nil
+	}
+	values := make(map[string]interface{}, len(params))
+	for key, p := range params {
+		switch v := p.ParameterChoice.(type) {
+		case *triton.InferParameter_BoolParam:
+			values[key] = v.BoolParam
+		case *triton.InferParameter_Int64Param:
+			values[key] = v.Int64Param
+		case *triton.InferParameter_StringParam:
+			values[key] = v.StringParam
+		}
+	}
+	return values
+}
+
+// inferParameters converts JSON parameter values to gRPC parameters.
+// Integral numbers become Int64Param; other numbers aren't representable
+// and are dropped.
+func inferParameters(values map[string]interface{}) map[string]*triton.InferParameter {
+	if len(values) == 0 {
+		return nil
+	}
+	params := make(map[string]*triton.InferParameter, len(values))
+	for key, value := range values {
+		switch v := value.(type) {
+		case bool:
+			params[key] = &triton.InferParameter{ParameterChoice: &triton.InferParameter_BoolParam{BoolParam: v}}
+		case float64:
+			if v == math.Trunc(v) {
+				params[key] = &triton.InferParameter{ParameterChoice: &triton.InferParameter_Int64Param{Int64Param: int64(v)}}
+			}
+		case string:
+			params[key] = &triton.InferParameter{ParameterChoice: &triton.InferParameter_StringParam{StringParam: v}}
+		}
+	}
+	return params
+}
+
+// modelPath returns the URL path of the named model and version.
+func modelPath(name, version string) string {
+	path := "/v2/models/" + url.PathEscape(name)
+	if version != "" {
+		path += "/versions/" + url.PathEscape(version)
+	}
+	return path
+}
+
+// call issues a request and returns the response body, or an error built
+// from the server's {"error": ...} body for any status other than 200.
+func (c *RESTClient) call(ctx context.Context, method, path string, headers http.Header, body io.Reader) ([]byte, http.Header, error) {
+	resp, err := c.do(ctx, method, path, headers, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var serverErr struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(data, &serverErr) == nil && serverErr.Error != "" {
+			return nil, nil, fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, serverErr.Error)
+		}
+		return nil, nil, fmt.Errorf("%s %s: %s", method, path, resp.Status)
+	}
+	return data, resp.Header, nil
+}
+
+// do sends a request bounded by the default timeout, as TritonClient
+// bounds its calls. The timeout covers reading the body, so it is
+// released only when the body is closed.
+func (c *RESTClient) do(ctx context.Context, method, path string, headers http.Header, body io.Reader) (*http.Response, error) {
+	cancel := context.CancelFunc(func() {})
+	if c.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.base+path, body)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	for key, values := range headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose releases a request's context when its body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
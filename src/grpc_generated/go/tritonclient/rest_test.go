@@ -0,0 +1,147 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// restAddServer is a v2 HTTP server for an "add" model whose single
+// INT32 output is its input plus one.
+func restAddServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/health/live", func(w http.ResponseWriter, _ *http.Request) {})
+	mux.HandleFunc("/v2/health/ready", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	mux.HandleFunc("/v2/models/add/versions/2", func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, `{"name":"add","versions":["2"],"platform":"python",`+
+			`"inputs":[{"name":"IN","datatype":"INT32","shape":[-1]}],`+
+			`"outputs":[{"name":"OUT","datatype":"INT32","shape":[-1]}]}`)
+	})
+	mux.HandleFunc("/v2/models/add/infer", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		n, err := strconv.Atoi(r.Header.Get(inferHeaderLength))
+		if err != nil {
+			t.Errorf("request has no %s header", inferHeaderLength)
+			return
+		}
+		var req restInferRequest
+		if err := json.Unmarshal(body[:n], &req); err != nil {
+			t.Errorf("request header: %v", err)
+			return
+		}
+		if req.Parameters["binary_data_output"] != true {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"error":"binary output not requested"}`)
+			return
+		}
+		in := DecodeInt32(body[n:])
+		for i := range in {
+			in[i]++
+		}
+		out, _ := EncodeTensor("INT32", in)
+		header, _ := json.Marshal(restInferResponse{
+			ModelName:    "add",
+			ModelVersion: "1",
+			ID:           req.ID,
+			Outputs: []restTensor{{
+				Name: "OUT", Datatype: "INT32", Shape: req.Inputs[0].Shape,
+				Parameters: map[string]interface{}{"binary_data_size": len(out)},
+			}},
+		})
+		w.Header().Set(inferHeaderLength, strconv.Itoa(len(header)))
+		w.Write(header)
+		w.Write(out)
+	})
+	mux.HandleFunc("/v2/models/missing/infer", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"error":"unknown model 'missing'"}`)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRESTClient(t *testing.T) {
+	srv := restAddServer(t)
+	client, err := NewRESTClient(srv.URL, nil)
+	if err != nil {
+		t.Fatalf("NewRESTClient: %v", err)
+	}
+	ctx := context.Background()
+
+	if live, err := client.Live(ctx); err != nil || !live {
+		t.Errorf("Live = %v, %v; want true", live, err)
+	}
+	if ready, err := client.Ready(ctx); err != nil || ready {
+		t.Errorf("Ready = %v, %v; want false", ready, err)
+	}
+
+	metadata, err := client.ModelMetadata(ctx, "add", "2")
+	if err != nil {
+		t.Fatalf("ModelMetadata: %v", err)
+	}
+	if metadata.Name != "add" || metadata.Outputs[0].Datatype != "INT32" || !reflect.DeepEqual(metadata.Outputs[0].Shape, []int64{-1}) {
+		t.Errorf("ModelMetadata = %v", metadata)
+	}
+
+	req, err := NewRequestBuilder("add", "").
+		WithId("r1").
+		WithInput("IN", "INT32", []int64{3}, []int32{1, 2, 3}).
+		WithOutput("OUT").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Infer(ctx, req)
+	if err != nil {
+		t.Fatalf("Infer: %v", err)
+	}
+	if err := CheckResponseID(req, resp); err != nil {
+		t.Error(err)
+	}
+	got, err := NewInferResult(resp).Output("OUT")
+	if err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+	if want := []int32{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OUT = %v, want %v", got, want)
+	}
+
+	req.ModelName = "missing"
+	if _, err := client.Infer(ctx, req); err == nil {
+		t.Error("Infer on an unknown model succeeded")
+	}
+}
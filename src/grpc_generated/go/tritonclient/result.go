@@ -0,0 +1,267 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"fmt"
+	"sync"
+
+	triton "nvidia_inferenceserver"
+)
+
+// InferResult wraps a ModelInferResponse to give access to its outputs by
+// name. Outputs are only decoded when asked for, so wide models pay
+// nothing for outputs the caller ignores. It is safe for concurrent use.
+type InferResult struct {
+	resp    *triton.ModelInferResponse
+	outputs map[string]*triton.ModelInferResponse_InferOutputTensor
+	raw     map[string][]byte
+
+	mu      sync.Mutex // guards decoded
+	decoded map[string]interface{}
+}
+
+// NewInferResult wraps resp. Raw output contents are matched to outputs
+// by position, as the server returns them.
+func NewInferResult(resp *triton.ModelInferResponse) *InferResult {
+	outputs := make(map[string]*triton.ModelInferResponse_InferOutputTensor, len(resp.Outputs))
+	for _, output := range resp.Outputs {
+		outputs[output.Name] = output
+	}
+	return &InferResult{resp: resp, outputs: outputs, raw: RawOutputsByName(resp), decoded: make(map[string]interface{})}
+}
+
+// Response returns the underlying response.
+func (r *InferResult) Response() *triton.ModelInferResponse {
+	return r.resp
+}
+
+// ModelName returns the name of the model that served the request.
+func (r *InferResult) ModelName() string {
+	return r.resp.ModelName
+}
+
+// ModelVersion returns the version of the model that served the request.
+// It is always a concrete version, even when the request asked for the
+// latest one, which makes it the value to record for reproducibility.
+func (r *InferResult) ModelVersion() string {
+	return r.resp.ModelVersion
+}
+
+// ID returns the request id the server echoed back.
+func (r *InferResult) ID() string {
+	return r.resp.Id
+}
+
+// Raw returns the undecoded contents of the named output, for forwarding
+// or caching without paying the decode cost.
+func (r *InferResult) Raw(name string) ([]byte, bool) {
+	raw, ok := r.raw[name]
+	return raw, ok
+}
+
+// Output decodes the named output according to its datatype: []bool for
+// BOOL, []int8, []int16, []int32 and []int64 for INT8 to INT64, []uint8,
+// []uint16, []uint32 and []uint64 for UINT8 to UINT64, []float32 for FP16,
+// BF16 and FP32, []float64 for FP64 and []string for BYTES.
+// Outputs the server returned inline in the tensor's Contents, rather than
+// as raw output contents, are converted to the same types. The decoded
+// slice is cached, so repeated calls decode only once.
+func (r *InferResult) Output(name string) (interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if decoded, ok := r.decoded[name]; ok {
+		return decoded, nil
+	}
+	output, ok := r.outputs[name]
+	if !ok {
+		return nil, fmt.Errorf("response has no output %s", name)
+	}
+	raw, ok := r.raw[name]
+	if !ok {
+		decoded, err := decodeContents(output)
+		if err != nil {
+			return nil, err
+		}
+		r.decoded[name] = decoded
+		return decoded, nil
+	}
+
+	if err := checkRawSize(name, output.Datatype, raw); err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	switch output.Datatype {
+	case "FP16":
+		decoded = DecodeFloat16(raw)
+	case "BF16":
+		decoded = DecodeBFloat16(raw)
+	case "FP32":
+		decoded = DecodeFloat32(raw)
+	case "INT32":
+		decoded = DecodeInt32(raw)
+	case "INT64":
+		decoded = DecodeInt64(raw)
+	case "BOOL":
+		decoded, _ = DecodeBool(raw, len(raw))
+	case "BYTES":
+		strs, err := DecodeBytes(raw)
+		if err != nil {
+			return nil, fmt.Errorf("output %s: %w", name, err)
+		}
+		decoded = strs
+	default:
+		var ok bool
+		if decoded, ok = decodeFixed(output.Datatype, raw); !ok {
+			return nil, fmt.Errorf("output %s has unsupported datatype %s", name, output.Datatype)
+		}
+	}
+	r.decoded[name] = decoded
+	return decoded, nil
+}
+
+// Float32 returns the named FP32 output, decoded and cached as by Output.
+// It returns an error if the output has another datatype.
+func (r *InferResult) Float32(name string) ([]float32, error) {
+	decoded, err := r.typedOutput(name, "FP32")
+	if err != nil {
+		return nil, err
+	}
+	return decoded.([]float32), nil
+}
+
+// Int32 returns the named INT32 output, like Float32.
+func (r *InferResult) Int32(name string) ([]int32, error) {
+	decoded, err := r.typedOutput(name, "INT32")
+	if err != nil {
+		return nil, err
+	}
+	return decoded.([]int32), nil
+}
+
+// Int64 returns the named INT64 output, like Float32.
+func (r *InferResult) Int64(name string) ([]int64, error) {
+	decoded, err := r.typedOutput(name, "INT64")
+	if err != nil {
+		return nil, err
+	}
+	return decoded.([]int64), nil
+}
+
+// Strings returns the named BYTES output, like Float32.
+func (r *InferResult) Strings(name string) ([]string, error) {
+	decoded, err := r.typedOutput(name, "BYTES")
+	if err != nil {
+		return nil, err
+	}
+	return decoded.([]string), nil
+}
+
+// typedOutput decodes the named output with Output after checking that
+// its datatype is datatype.
+func (r *InferResult) typedOutput(name, datatype string) (interface{}, error) {
+	output, ok := r.outputs[name]
+	if !ok {
+		return nil, fmt.Errorf("response has no output %s", name)
+	}
+	if output.Datatype != datatype {
+		return nil, fmt.Errorf("output %s has datatype %s, not %s", name, output.Datatype, datatype)
+	}
+	return r.Output(name)
+}
+
+// Reshaped decodes the named output as Output does and nests it following
+// the output's shape, as Reshape does. It returns an error if the number
+// of elements disagrees with the shape.
+func (r *InferResult) Reshaped(name string) (interface{}, error) {
+	decoded, err := r.Output(name)
+	if err != nil {
+		return nil, err
+	}
+	reshaped, err := Reshape(decoded, r.outputs[name].Shape)
+	if err != nil {
+		return nil, fmt.Errorf("output %s: %w", name, err)
+	}
+	return reshaped, nil
+}
+
+// Outputs decodes only the named outputs, as Output does, and returns
+// them keyed by name. The rest of the response is left undecoded.
+func (r *InferResult) Outputs(names ...string) (map[string]interface{}, error) {
+	outputs := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		decoded, err := r.Output(name)
+		if err != nil {
+			return nil, err
+		}
+		outputs[name] = decoded
+	}
+	return outputs, nil
+}
+
+// Decode decodes several outputs in one pass. targets maps output names to
+// pointers to typed slices: *[]float32 for FP32, *[]int32 for INT32 and
+// *[]int64 for INT64. Each output's datatype must match its target. As
+// with Output, outputs may be raw or inline contents.
+func (r *InferResult) Decode(targets map[string]interface{}) error {
+	for name, target := range targets {
+		output, ok := r.outputs[name]
+		if !ok {
+			return fmt.Errorf("response has no output %s", name)
+		}
+
+		var datatype string
+		switch target.(type) {
+		case *[]float32:
+			datatype = "FP32"
+		case *[]int32:
+			datatype = "INT32"
+		case *[]int64:
+			datatype = "INT64"
+		default:
+			return fmt.Errorf("output %s: unsupported decode target %T", name, target)
+		}
+		if output.Datatype != datatype {
+			return fmt.Errorf("output %s has datatype %s, cannot decode into %T", name, output.Datatype, target)
+		}
+		decoded, err := r.Output(name)
+		if err != nil {
+			return err
+		}
+
+		switch dst := target.(type) {
+		case *[]float32:
+			*dst = decoded.([]float32)
+		case *[]int32:
+			*dst = decoded.([]int32)
+		case *[]int64:
+			*dst = decoded.([]int64)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestInferResultRaw(t *testing.T) {
+	result := NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT1", Datatype: "INT32", Shape: []int64{1}},
+			{Name: "OUTPUT0", Datatype: "INT32", Shape: []int64{1}},
+		},
+		RawOutputContents: [][]byte{{1, 0, 0, 0}, {2, 0, 0, 0}},
+	})
+
+	raw, ok := result.Raw("OUTPUT0")
+	if !ok || !bytes.Equal(raw, []byte{2, 0, 0, 0}) {
+		t.Errorf("Raw(OUTPUT0) = %v, %v", raw, ok)
+	}
+	if _, ok := result.Raw("OUTPUT2"); ok {
+		t.Error("Raw(OUTPUT2) found a missing output")
+	}
+}
+
+func TestInferResultServedModel(t *testing.T) {
+	result := NewInferResult(&triton.ModelInferResponse{ModelName: "simple", ModelVersion: "3", Id: "r1"})
+	if result.ModelName() != "simple" || result.ModelVersion() != "3" || result.ID() != "r1" {
+		t.Errorf("served model = %s version %s id %s, want simple version 3 id r1", result.ModelName(), result.ModelVersion(), result.ID())
+	}
+}
+
+func TestInferResultDecode(t *testing.T) {
+	result := NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "scores", Datatype: "FP32", Shape: []int64{2}},
+			{Name: "labels", Datatype: "INT64", Shape: []int64{1}},
+		},
+		RawOutputContents: [][]byte{
+			float32Bytes([]float32{0.25, 0.75}),
+			{7, 0, 0, 0, 0, 0, 0, 0},
+		},
+	})
+
+	var scores []float32
+	var labels []int64
+	err := result.Decode(map[string]interface{}{"scores": &scores, "labels": &labels})
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(scores) != 2 || scores[0] != 0.25 || scores[1] != 0.75 {
+		t.Errorf("scores = %v", scores)
+	}
+	if len(labels) != 1 || labels[0] != 7 {
+		t.Errorf("labels = %v", labels)
+	}
+
+	var wrong []int32
+	if err := result.Decode(map[string]interface{}{"scores": &wrong}); err == nil {
+		t.Error("Decode of FP32 into *[]int32 succeeded")
+	}
+	if err := result.Decode(map[string]interface{}{"missing": &wrong}); err == nil {
+		t.Error("Decode of a missing output succeeded")
+	}
+}
+
+func TestInferResultOutputs(t *testing.T) {
+	result := NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "scores", Datatype: "FP32", Shape: []int64{2}},
+			{Name: "text", Datatype: "BYTES", Shape: []int64{1}},
+			{Name: "unused", Datatype: "UNKNOWN", Shape: []int64{1}},
+		},
+		RawOutputContents: [][]byte{
+			float32Bytes([]float32{0.25, 0.75}),
+			{2, 0, 0, 0, 'h', 'i'},
+			{0, 0},
+		},
+	})
+
+	// The unused output is never requested, so it is never decoded.
+	outputs, err := result.Outputs("scores", "text")
+	if err != nil {
+		t.Fatalf("Outputs: %v", err)
+	}
+	if scores, ok := outputs["scores"].([]float32); !ok || len(scores) != 2 || scores[1] != 0.75 {
+		t.Errorf("scores = %#v", outputs["scores"])
+	}
+	if text, ok := outputs["text"].([]string); !ok || len(text) != 1 || text[0] != "hi" {
+		t.Errorf("text = %#v", outputs["text"])
+	}
+	if len(result.decoded) != 2 {
+		t.Errorf("decoded %d outputs, want 2", len(result.decoded))
+	}
+
+	if _, err := result.Output("unused"); err == nil {
+		t.Error("Output of an unknown datatype succeeded")
+	}
+	if _, err := result.Outputs("missing"); err == nil {
+		t.Error("Outputs of a missing output succeeded")
+	}
+}
+
+func TestInferResultTypedAccessors(t *testing.T) {
+	result := NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT0", Datatype: "FP32", Shape: []int64{1}},
+			{Name: "labels", Datatype: "INT64", Shape: []int64{1}},
+			{Name: "counts", Datatype: "INT32", Shape: []int64{1}},
+			{Name: "text", Datatype: "BYTES", Shape: []int64{1}},
+		},
+		RawOutputContents: [][]byte{
+			float32Bytes([]float32{0.5}),
+			{9, 0, 0, 0, 0, 0, 0, 0},
+			{3, 0, 0, 0},
+			{2, 0, 0, 0, 'h', 'i'},
+		},
+	})
+
+	if got, err := result.Float32("OUTPUT0"); err != nil || len(got) != 1 || got[0] != 0.5 {
+		t.Errorf("Float32 = %v, %v", got, err)
+	}
+	if got, err := result.Int64("labels"); err != nil || len(got) != 1 || got[0] != 9 {
+		t.Errorf("Int64 = %v, %v", got, err)
+	}
+	if got, err := result.Int32("counts"); err != nil || len(got) != 1 || got[0] != 3 {
+		t.Errorf("Int32 = %v, %v", got, err)
+	}
+	if got, err := result.Strings("text"); err != nil || len(got) != 1 || got[0] != "hi" {
+		t.Errorf("Strings = %q, %v", got, err)
+	}
+
+	if _, err := result.Int64("OUTPUT0"); err == nil {
+		t.Error("Int64 of an FP32 output succeeded")
+	}
+	if _, err := result.Float32("missing"); err == nil {
+		t.Error("Float32 of a missing output succeeded")
+	}
+}
+
+func TestInferResultPartialElement(t *testing.T) {
+	result := NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT0", Datatype: "INT32", Shape: []int64{2}},
+		},
+		RawOutputContents: [][]byte{{1, 0, 0, 0, 2, 0}},
+	})
+
+	if _, err := result.Output("OUTPUT0"); err == nil || !strings.Contains(err.Error(), "OUTPUT0") {
+		t.Errorf("Output of a 6-byte INT32 buffer: %v", err)
+	}
+	var dst []int32
+	if err := result.Decode(map[string]interface{}{"OUTPUT0": &dst}); err == nil {
+		t.Error("Decode of a 6-byte INT32 buffer succeeded")
+	}
+	if err := CheckRawOutputSize(result.Response(), 0); err == nil {
+		t.Error("CheckRawOutputSize of a 6-byte INT32 buffer succeeded")
+	}
+}
+
+func TestInferResultInlineContents(t *testing.T) {
+	result := NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "scores", Datatype: "FP32", Shape: []int64{2},
+				Contents: &triton.InferTensorContents{Fp32Contents: []float32{0.25, 0.75}}},
+			{Name: "labels", Datatype: "BYTES", Shape: []int64{1},
+				Contents: &triton.InferTensorContents{BytesContents: [][]byte{[]byte("cat")}}},
+			{Name: "empty", Datatype: "INT32", Shape: []int64{1}},
+		},
+	})
+
+	var scores []float32
+	if err := result.Decode(map[string]interface{}{"scores": &scores}); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(scores) != 2 || scores[0] != 0.25 || scores[1] != 0.75 {
+		t.Errorf("scores = %v", scores)
+	}
+	labels, err := result.Output("labels")
+	if err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+	if got, ok := labels.([]string); !ok || len(got) != 1 || got[0] != "cat" {
+		t.Errorf("labels = %#v", labels)
+	}
+	if _, err := result.Output("empty"); err == nil {
+		t.Error("Output of an output with neither raw nor inline contents succeeded")
+	}
+}
+
+// TestInferResultDatatypes checks that inline and raw contents of each
+// datatype decode to the same Go type.
+func TestInferResultDatatypes(t *testing.T) {
+	tests := []struct {
+		datatype string
+		contents *triton.InferTensorContents
+		raw      []byte
+		want     interface{}
+	}{
+		{"BOOL", &triton.InferTensorContents{BoolContents: []bool{true, false}}, []byte{1, 0}, []bool{true, false}},
+		{"INT8", &triton.InferTensorContents{IntContents: []int32{-1, 2}}, []byte{0xff, 2}, []int8{-1, 2}},
+		{"INT16", &triton.InferTensorContents{IntContents: []int32{-1, 2}}, []byte{0xff, 0xff, 2, 0}, []int16{-1, 2}},
+		{"UINT8", &triton.InferTensorContents{UintContents: []uint32{255, 2}}, []byte{255, 2}, []uint8{255, 2}},
+		{"UINT16", &triton.InferTensorContents{UintContents: []uint32{65535, 2}}, []byte{0xff, 0xff, 2, 0}, []uint16{65535, 2}},
+		{"UINT32", &triton.InferTensorContents{UintContents: []uint32{1, 2}}, []byte{1, 0, 0, 0, 2, 0, 0, 0}, []uint32{1, 2}},
+		{"UINT64", &triton.InferTensorContents{Uint64Contents: []uint64{1, 2}},
+			[]byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0}, []uint64{1, 2}},
+		{"FP64", &triton.InferTensorContents{Fp64Contents: []float64{0.5, -2}},
+			[]byte{0, 0, 0, 0, 0, 0, 0xe0, 0x3f, 0, 0, 0, 0, 0, 0, 0, 0xc0}, []float64{0.5, -2}},
+	}
+	for _, tt := range tests {
+		result := NewInferResult(&triton.ModelInferResponse{
+			Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+				{Name: "raw", Datatype: tt.datatype, Shape: []int64{2}},
+				{Name: "inline", Datatype: tt.datatype, Shape: []int64{2}, Contents: tt.contents},
+			},
+			RawOutputContents: [][]byte{tt.raw},
+		})
+		for _, name := range []string{"raw", "inline"} {
+			got, err := result.Output(name)
+			if err != nil {
+				t.Errorf("%s %s Output: %v", name, tt.datatype, err)
+				continue
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s %s Output = %#v, want %#v", name, tt.datatype, got, tt.want)
+			}
+		}
+	}
+
+	// Half-precision outputs only come as raw contents and widen to float32.
+	result := NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "half", Datatype: "FP16", Shape: []int64{2}},
+			{Name: "brain", Datatype: "BF16", Shape: []int64{2}},
+		},
+		RawOutputContents: [][]byte{{0x00, 0x38, 0x00, 0xc0}, {0x00, 0x3f, 0x40, 0xc0}},
+	})
+	for name, want := range map[string][]float32{"half": {0.5, -2}, "brain": {0.5, -3}} {
+		got, err := result.Output(name)
+		if err != nil {
+			t.Errorf("%s Output: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s Output = %#v, want %#v", name, got, want)
+		}
+	}
+
+	result = NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "short", Datatype: "FP32", Shape: []int64{1, 3},
+				Contents: &triton.InferTensorContents{Fp32Contents: []float32{1, 2}}},
+		},
+	})
+	if _, err := result.Output("short"); err == nil {
+		t.Error("Output of inline contents with fewer elements than the shape succeeded")
+	}
+}
+
+func TestInferResultConcurrentOutput(t *testing.T) {
+	result := NewInferResult(&triton.ModelInferResponse{
+		Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+			{Name: "OUTPUT0", Datatype: "FP32", Shape: []int64{2}},
+			{Name: "OUTPUT1", Datatype: "INT32", Shape: []int64{1}},
+		},
+		RawOutputContents: [][]byte{float32Bytes([]float32{1, 2}), {3, 0, 0, 0}},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, name := range []string{"OUTPUT0", "OUTPUT1"} {
+				if _, err := result.Output(name); err != nil {
+					t.Errorf("Output(%s): %v", name, err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithRetry retries idempotent calls that fail with Unavailable or
+// ResourceExhausted, making up to maxAttempts attempts in all. The delay
+// before the nth retry is baseDelay doubled n-1 times. Retrying stops early
+// if the context is done or its deadline would pass during the delay.
+//
+// Health, metadata, config, statistics and index calls are idempotent.
+// Inference is retried only when its context comes from
+// ContextWithRetryableInfer, since a retried inference may run twice.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(o *options) {
+		if maxAttempts > 1 {
+			o.unaryInterceptors = append(o.unaryInterceptors, retryInterceptor(maxAttempts, baseDelay))
+		}
+	}
+}
+
+type retryableInferKey struct{}
+
+// ContextWithRetryableInfer returns a context whose inference requests
+// WithRetry may retry. Use it only for models where running a request
+// more than once is harmless.
+func ContextWithRetryableInfer(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryableInferKey{}, true)
+}
+
+func retryInterceptor(maxAttempts int, baseDelay time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !idempotent(ctx, req) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		delay := baseDelay
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt == maxAttempts || !retryable(err) {
+				return err
+			}
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+				return err
+			}
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			}
+			delay *= 2
+		}
+	}
+}
+
+// idempotent reports whether req may safely be sent more than once.
+func idempotent(ctx context.Context, req interface{}) bool {
+	switch req.(type) {
+	case *triton.ServerLiveRequest, *triton.ServerReadyRequest, *triton.ModelReadyRequest,
+		*triton.ServerMetadataRequest, *triton.ModelMetadataRequest, *triton.ModelConfigRequest,
+		*triton.ModelStatisticsRequest, *triton.RepositoryIndexRequest,
+		*triton.SystemSharedMemoryStatusRequest, *triton.CudaSharedMemoryStatusRequest:
+		return true
+	case *triton.ModelInferRequest:
+		retryable, _ := ctx.Value(retryableInferKey{}).(bool)
+		return retryable
+	}
+	return false
+}
+
+// retryable reports whether err is transient and worth retrying.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
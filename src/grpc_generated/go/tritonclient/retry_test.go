@@ -0,0 +1,119 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetry(t *testing.T) {
+	var calls, failures int32
+	code := codes.Unavailable
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			atomic.AddInt32(&calls, 1)
+			if atomic.AddInt32(&failures, -1) >= 0 {
+				return nil, status.Error(code, "transient")
+			}
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+		modelInfer: func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, status.Error(codes.Unavailable, "transient")
+		},
+	}, WithRetry(3, time.Millisecond))
+	ctx := context.Background()
+
+	tests := []struct {
+		code     codes.Code
+		failures int32
+		wantOK   bool
+		calls    int32
+	}{
+		{codes.Unavailable, 2, true, 3},
+		{codes.ResourceExhausted, 1, true, 2},
+		{codes.Unavailable, 5, false, 3},
+		{codes.InvalidArgument, 1, false, 1},
+	}
+	for _, tt := range tests {
+		code = tt.code
+		atomic.StoreInt32(&failures, tt.failures)
+		atomic.StoreInt32(&calls, 0)
+		live, err := client.Live(ctx)
+		if tt.wantOK && (err != nil || !live) {
+			t.Errorf("%v x%d: Live = %v, %v", tt.code, tt.failures, live, err)
+		}
+		if !tt.wantOK && status.Code(err) != tt.code {
+			t.Errorf("%v x%d: Live error = %v, want %v", tt.code, tt.failures, err, tt.code)
+		}
+		if n := atomic.LoadInt32(&calls); n != tt.calls {
+			t.Errorf("%v x%d: server saw %d calls, want %d", tt.code, tt.failures, n, tt.calls)
+		}
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	client.Infer(ctx, &triton.ModelInferRequest{})
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("unmarked Infer sent %d times, want 1", n)
+	}
+	atomic.StoreInt32(&calls, 0)
+	client.Infer(ContextWithRetryableInfer(ctx), &triton.ModelInferRequest{})
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("retryable Infer sent %d times, want 3", n)
+	}
+}
+
+func TestRetryHonorsDeadline(t *testing.T) {
+	var calls int32
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, status.Error(codes.Unavailable, "down")
+		},
+	}, WithRetry(5, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	if _, err := client.Live(ctx); status.Code(err) != codes.Unavailable {
+		t.Errorf("Live error = %v, want Unavailable", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Live took %v despite a delay past the deadline", elapsed)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("server saw %d calls, want 1", n)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SequenceOptions identifies a request as part of a sequence for models
+// using the sequence batcher. Every request of a sequence carries the same
+// SequenceID, so the server routes them to the same model instance; Start
+// marks the first request and End the last.
+type SequenceOptions struct {
+	SequenceID uint64
+	Start      bool
+	End        bool
+}
+
+func (s SequenceOptions) validate() error {
+	if s.SequenceID == 0 {
+		return errors.New("sequence id must be non-zero")
+	}
+	if s.SequenceID > 1<<63-1 {
+		return fmt.Errorf("sequence id %d doesn't fit an int64 parameter", s.SequenceID)
+	}
+	return nil
+}
+
+// WithSequence sets the sequence_id, sequence_start and sequence_end
+// parameters from seq.
+func (b *RequestBuilder) WithSequence(seq SequenceOptions) *RequestBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := seq.validate(); err != nil {
+		b.err = err
+		return b
+	}
+	return b.WithParameter("sequence_id", int64(seq.SequenceID)).
+		WithParameter("sequence_start", seq.Start).
+		WithParameter("sequence_end", seq.End)
+}
+
+// WithSingleRequestSequence is WithSequence for a sequence made of this
+// request alone, which must therefore start or end it.
+func (b *RequestBuilder) WithSingleRequestSequence(seq SequenceOptions) *RequestBuilder {
+	if b.err == nil && !seq.Start && !seq.End {
+		b.err = fmt.Errorf("single-request sequence %d neither starts nor ends", seq.SequenceID)
+		return b
+	}
+	return b.WithSequence(seq)
+}
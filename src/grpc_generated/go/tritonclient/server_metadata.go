@@ -0,0 +1,72 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	triton "nvidia_inferenceserver"
+)
+
+// ServerMetadata returns the server's name, version and supported
+// extensions.
+func (c *TritonClient) ServerMetadata(ctx context.Context) (*triton.ServerMetadataResponse, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	return c.client.ServerMetadata(ctx, &triton.ServerMetadataRequest{})
+}
+
+// HasExtension reports whether the server supports the named protocol
+// extension, such as "system_shared_memory", "cuda_shared_memory" or
+// "model_repository".
+func HasExtension(metadata *triton.ServerMetadataResponse, extension string) bool {
+	for _, e := range metadata.GetExtensions() {
+		if e == extension {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrUnsupportedExtension is returned, wrapped, by calls that need a
+// protocol extension the server does not advertise.
+var ErrUnsupportedExtension = errors.New("unsupported extension")
+
+// requireExtension returns an error wrapping ErrUnsupportedExtension
+// unless the server advertises extension in its metadata.
+func (c *TritonClient) requireExtension(ctx context.Context, extension string) error {
+	metadata, err := c.ServerMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	if !HasExtension(metadata, extension) {
+		return fmt.Errorf("server %s %s: %w %q", metadata.Name, metadata.Version, ErrUnsupportedExtension, extension)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+)
+
+// fakeServer implements the RPCs a test sets hooks for and leaves the rest
+// unimplemented.
+type fakeServer struct {
+	triton.UnimplementedGRPCInferenceServiceServer
+	serverLive       func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error)
+	serverMetadata   func(context.Context, *triton.ServerMetadataRequest) (*triton.ServerMetadataResponse, error)
+	serverReady      func(context.Context, *triton.ServerReadyRequest) (*triton.ServerReadyResponse, error)
+	modelReady       func(context.Context, *triton.ModelReadyRequest) (*triton.ModelReadyResponse, error)
+	modelConfig      func(context.Context, *triton.ModelConfigRequest) (*triton.ModelConfigResponse, error)
+	modelMetadata    func(context.Context, *triton.ModelMetadataRequest) (*triton.ModelMetadataResponse, error)
+	repositoryIndex  func(context.Context, *triton.RepositoryIndexRequest) (*triton.RepositoryIndexResponse, error)
+	modelLoad        func(context.Context, *triton.RepositoryModelLoadRequest) (*triton.RepositoryModelLoadResponse, error)
+	modelUnload      func(context.Context, *triton.RepositoryModelUnloadRequest) (*triton.RepositoryModelUnloadResponse, error)
+	systemRegister   func(context.Context, *triton.SystemSharedMemoryRegisterRequest) (*triton.SystemSharedMemoryRegisterResponse, error)
+	systemUnregister func(context.Context, *triton.SystemSharedMemoryUnregisterRequest) (*triton.SystemSharedMemoryUnregisterResponse, error)
+	systemStatus     func(context.Context, *triton.SystemSharedMemoryStatusRequest) (*triton.SystemSharedMemoryStatusResponse, error)
+	cudaRegister     func(context.Context, *triton.CudaSharedMemoryRegisterRequest) (*triton.CudaSharedMemoryRegisterResponse, error)
+	cudaUnregister   func(context.Context, *triton.CudaSharedMemoryUnregisterRequest) (*triton.CudaSharedMemoryUnregisterResponse, error)
+	cudaStatus       func(context.Context, *triton.CudaSharedMemoryStatusRequest) (*triton.CudaSharedMemoryStatusResponse, error)
+	modelStatistics  func(context.Context, *triton.ModelStatisticsRequest) (*triton.ModelStatisticsResponse, error)
+	modelInfer       func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error)
+	modelStreamInfer func(triton.GRPCInferenceService_ModelStreamInferServer) error
+	traceSetting     func(context.Context, *triton.TraceSettingRequest) (*triton.TraceSettingResponse, error)
+	logSettings      func(context.Context, *triton.LogSettingsRequest) (*triton.LogSettingsResponse, error)
+}
+
+func (s *fakeServer) ServerLive(ctx context.Context, req *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+	if s.serverLive == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.ServerLive(ctx, req)
+	}
+	return s.serverLive(ctx, req)
+}
+
+func (s *fakeServer) ServerMetadata(ctx context.Context, req *triton.ServerMetadataRequest) (*triton.ServerMetadataResponse, error) {
+	if s.serverMetadata == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.ServerMetadata(ctx, req)
+	}
+	return s.serverMetadata(ctx, req)
+}
+
+func (s *fakeServer) ServerReady(ctx context.Context, req *triton.ServerReadyRequest) (*triton.ServerReadyResponse, error) {
+	if s.serverReady == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.ServerReady(ctx, req)
+	}
+	return s.serverReady(ctx, req)
+}
+
+func (s *fakeServer) ModelReady(ctx context.Context, req *triton.ModelReadyRequest) (*triton.ModelReadyResponse, error) {
+	if s.modelReady == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.ModelReady(ctx, req)
+	}
+	return s.modelReady(ctx, req)
+}
+
+func (s *fakeServer) ModelConfig(ctx context.Context, req *triton.ModelConfigRequest) (*triton.ModelConfigResponse, error) {
+	if s.modelConfig == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.ModelConfig(ctx, req)
+	}
+	return s.modelConfig(ctx, req)
+}
+
+func (s *fakeServer) ModelMetadata(ctx context.Context, req *triton.ModelMetadataRequest) (*triton.ModelMetadataResponse, error) {
+	if s.modelMetadata == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.ModelMetadata(ctx, req)
+	}
+	return s.modelMetadata(ctx, req)
+}
+
+func (s *fakeServer) RepositoryIndex(ctx context.Context, req *triton.RepositoryIndexRequest) (*triton.RepositoryIndexResponse, error) {
+	if s.repositoryIndex == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.RepositoryIndex(ctx, req)
+	}
+	return s.repositoryIndex(ctx, req)
+}
+
+func (s *fakeServer) RepositoryModelLoad(ctx context.Context, req *triton.RepositoryModelLoadRequest) (*triton.RepositoryModelLoadResponse, error) {
+	if s.modelLoad == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.RepositoryModelLoad(ctx, req)
+	}
+	return s.modelLoad(ctx, req)
+}
+
+func (s *fakeServer) RepositoryModelUnload(ctx context.Context, req *triton.RepositoryModelUnloadRequest) (*triton.RepositoryModelUnloadResponse, error) {
+	if s.modelUnload == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.RepositoryModelUnload(ctx, req)
+	}
+	return s.modelUnload(ctx, req)
+}
+
+func (s *fakeServer) SystemSharedMemoryRegister(ctx context.Context, req *triton.SystemSharedMemoryRegisterRequest) (*triton.SystemSharedMemoryRegisterResponse, error) {
+	if s.systemRegister == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.SystemSharedMemoryRegister(ctx, req)
+	}
+	return s.systemRegister(ctx, req)
+}
+
+func (s *fakeServer) SystemSharedMemoryUnregister(ctx context.Context, req *triton.SystemSharedMemoryUnregisterRequest) (*triton.SystemSharedMemoryUnregisterResponse, error) {
+	if s.systemUnregister == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.SystemSharedMemoryUnregister(ctx, req)
+	}
+	return s.systemUnregister(ctx, req)
+}
+
+func (s *fakeServer) SystemSharedMemoryStatus(ctx context.Context, req *triton.SystemSharedMemoryStatusRequest) (*triton.SystemSharedMemoryStatusResponse, error) {
+	if s.systemStatus == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.SystemSharedMemoryStatus(ctx, req)
+	}
+	return s.systemStatus(ctx, req)
+}
+
+func (s *fakeServer) CudaSharedMemoryRegister(ctx context.Context, req *triton.CudaSharedMemoryRegisterRequest) (*triton.CudaSharedMemoryRegisterResponse, error) {
+	if s.cudaRegister == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.CudaSharedMemoryRegister(ctx, req)
+	}
+	return s.cudaRegister(ctx, req)
+}
+
+func (s *fakeServer) CudaSharedMemoryUnregister(ctx context.Context, req *triton.CudaSharedMemoryUnregisterRequest) (*triton.CudaSharedMemoryUnregisterResponse, error) {
+	if s.cudaUnregister == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.CudaSharedMemoryUnregister(ctx, req)
+	}
+	return s.cudaUnregister(ctx, req)
+}
+
+func (s *fakeServer) CudaSharedMemoryStatus(ctx context.Context, req *triton.CudaSharedMemoryStatusRequest) (*triton.CudaSharedMemoryStatusResponse, error) {
+	if s.cudaStatus == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.CudaSharedMemoryStatus(ctx, req)
+	}
+	return s.cudaStatus(ctx, req)
+}
+
+func (s *fakeServer) ModelStatistics(ctx context.Context, req *triton.ModelStatisticsRequest) (*triton.ModelStatisticsResponse, error) {
+	if s.modelStatistics == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.ModelStatistics(ctx, req)
+	}
+	return s.modelStatistics(ctx, req)
+}
+
+func (s *fakeServer) ModelInfer(ctx context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+	if s.modelInfer == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.ModelInfer(ctx, req)
+	}
+	return s.modelInfer(ctx, req)
+}
+
+func (s *fakeServer) ModelStreamInfer(stream triton.GRPCInferenceService_ModelStreamInferServer) error {
+	if s.modelStreamInfer == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.ModelStreamInfer(stream)
+	}
+	return s.modelStreamInfer(stream)
+}
+
+func (s *fakeServer) TraceSetting(ctx context.Context, req *triton.TraceSettingRequest) (*triton.TraceSettingResponse, error) {
+	if s.traceSetting == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.TraceSetting(ctx, req)
+	}
+	return s.traceSetting(ctx, req)
+}
+
+func (s *fakeServer) LogSettings(ctx context.Context, req *triton.LogSettingsRequest) (*triton.LogSettingsResponse, error) {
+	if s.logSettings == nil {
+		return s.UnimplementedGRPCInferenceServiceServer.LogSettings(ctx, req)
+	}
+	return s.logSettings(ctx, req)
+}
+
+// startFakeServer serves srv on a loopback port and returns a client
+// connected to it. Both are shut down when the test ends.
+func startFakeServer(t *testing.T, srv *fakeServer, opts ...Option) *TritonClient {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := grpc.NewServer()
+	triton.RegisterGRPCInferenceServiceServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	client, err := NewTritonClient(lis.Addr().String(), opts...)
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+
+	triton "nvidia_inferenceserver"
+)
+
+// RegisterCudaSharedMemory registers a CUDA memory region with the server
+// under name. rawHandle is the serialized cudaIpcMemHandle_t of the
+// allocation on device deviceID, and byteSize its size.
+func (c *TritonClient) RegisterCudaSharedMemory(ctx context.Context, name string, rawHandle []byte, deviceID int64, byteSize uint64) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	_, err := c.client.CudaSharedMemoryRegister(ctx, &triton.CudaSharedMemoryRegisterRequest{
+		Name:      name,
+		RawHandle: rawHandle,
+		DeviceId:  deviceID,
+		ByteSize:  byteSize,
+	})
+	return err
+}
+
+// UnregisterCudaSharedMemory unregisters the named CUDA shared memory
+// region, or every registered region if name is empty.
+func (c *TritonClient) UnregisterCudaSharedMemory(ctx context.Context, name string) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	_, err := c.client.CudaSharedMemoryUnregister(ctx, &triton.CudaSharedMemoryUnregisterRequest{Name: name})
+	return err
+}
+
+// CudaSharedMemoryStatus returns the registered CUDA shared memory regions
+// keyed by name: only the named one, or all of them if name is empty.
+func (c *TritonClient) CudaSharedMemoryStatus(ctx context.Context, name string) (map[string]*triton.CudaSharedMemoryStatusResponse_RegionStatus, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	resp, err := c.client.CudaSharedMemoryStatus(ctx, &triton.CudaSharedMemoryStatusRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Regions, nil
+}
+
+// RegisterSystemSharedMemory registers a system shared memory region with
+// the server under name. key is the shared memory object the server opens
+// (e.g. "/input_data" for /dev/shm/input_data), and the region covers
+// byteSize bytes of it starting at offset.
+func (c *TritonClient) RegisterSystemSharedMemory(ctx context.Context, name, key string, offset, byteSize uint64) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	_, err := c.client.SystemSharedMemoryRegister(ctx, &triton.SystemSharedMemoryRegisterRequest{
+		Name:     name,
+		Key:      key,
+		Offset:   offset,
+		ByteSize: byteSize,
+	})
+	return err
+}
+
+// UnregisterSystemSharedMemory unregisters the named system shared memory
+// region, or every registered region if name is empty.
+func (c *TritonClient) UnregisterSystemSharedMemory(ctx context.Context, name string) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	_, err := c.client.SystemSharedMemoryUnregister(ctx, &triton.SystemSharedMemoryUnregisterRequest{Name: name})
+	return err
+}
+
+// SystemSharedMemoryStatus returns the registered system shared memory
+// regions keyed by name: only the named one, or all of them if name is
+// empty.
+func (c *TritonClient) SystemSharedMemoryStatus(ctx context.Context, name string) (map[string]*triton.SystemSharedMemoryStatusResponse_RegionStatus, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	resp, err := c.client.SystemSharedMemoryStatus(ctx, &triton.SystemSharedMemoryStatusRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Regions, nil
+}
+
+// sharedMemoryParameters returns the tensor parameters that point the
+// server at byteSize bytes of a registered region, starting at offset.
+func sharedMemoryParameters(region string, byteSize, offset uint64) map[string]*triton.InferParameter {
+	params := map[string]*triton.InferParameter{
+		"shared_memory_region":    {ParameterChoice: &triton.InferParameter_StringParam{StringParam: region}},
+		"shared_memory_byte_size": {ParameterChoice: &triton.InferParameter_Int64Param{Int64Param: int64(byteSize)}},
+	}
+	if offset != 0 {
+		params["shared_memory_offset"] = &triton.InferParameter{ParameterChoice: &triton.InferParameter_Int64Param{Int64Param: int64(offset)}}
+	}
+	return params
+}
+
+// WithSharedMemoryInput adds an input tensor whose contents the server
+// reads from byteSize bytes of a registered shared memory region, CUDA or
+// system, starting at offset. The input has no raw contents.
+func (b *RequestBuilder) WithSharedMemoryInput(name, datatype string, shape []int64, region string, byteSize, offset uint64) *RequestBuilder {
+	b.req.Inputs = append(b.req.Inputs, &triton.ModelInferRequest_InferInputTensor{
+		Name:       name,
+		Datatype:   datatype,
+		Shape:      shape,
+		Parameters: sharedMemoryParameters(region, byteSize, offset),
+	})
+	return b
+}
+
+// WithSharedMemoryOutput requests the named output and has the server
+// write it to byteSize bytes of a registered shared memory region, CUDA
+// or system, starting at offset, instead of returning raw contents.
+func (b *RequestBuilder) WithSharedMemoryOutput(name, region string, byteSize, offset uint64) *RequestBuilder {
+	b.req.Outputs = append(b.req.Outputs, &triton.ModelInferRequest_InferRequestedOutputTensor{
+		Name:       name,
+		Parameters: sharedMemoryParameters(region, byteSize, offset),
+	})
+	return b
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestCudaSharedMemory(t *testing.T) {
+	regions := make(map[string]*triton.CudaSharedMemoryStatusResponse_RegionStatus)
+	client := startFakeServer(t, &fakeServer{
+		cudaRegister: func(_ context.Context, req *triton.CudaSharedMemoryRegisterRequest) (*triton.CudaSharedMemoryRegisterResponse, error) {
+			regions[req.Name] = &triton.CudaSharedMemoryStatusResponse_RegionStatus{
+				Name:     req.Name,
+				DeviceId: uint64(req.DeviceId),
+				ByteSize: req.ByteSize,
+			}
+			return &triton.CudaSharedMemoryRegisterResponse{}, nil
+		},
+		cudaUnregister: func(_ context.Context, req *triton.CudaSharedMemoryUnregisterRequest) (*triton.CudaSharedMemoryUnregisterResponse, error) {
+			delete(regions, req.Name)
+			return &triton.CudaSharedMemoryUnregisterResponse{}, nil
+		},
+		cudaStatus: func(context.Context, *triton.CudaSharedMemoryStatusRequest) (*triton.CudaSharedMemoryStatusResponse, error) {
+			return &triton.CudaSharedMemoryStatusResponse{Regions: regions}, nil
+		},
+	})
+	ctx := context.Background()
+
+	if err := client.RegisterCudaSharedMemory(ctx, "input_data", []byte{1, 2, 3}, 1, 64); err != nil {
+		t.Fatalf("RegisterCudaSharedMemory: %v", err)
+	}
+	status, err := client.CudaSharedMemoryStatus(ctx, "")
+	if err != nil {
+		t.Fatalf("CudaSharedMemoryStatus: %v", err)
+	}
+	if region := status["input_data"]; region == nil || region.DeviceId != 1 || region.ByteSize != 64 {
+		t.Errorf("input_data status = %v", region)
+	}
+
+	if err := client.UnregisterCudaSharedMemory(ctx, "input_data"); err != nil {
+		t.Fatalf("UnregisterCudaSharedMemory: %v", err)
+	}
+	if status, _ := client.CudaSharedMemoryStatus(ctx, ""); len(status) != 0 {
+		t.Errorf("regions after unregister = %v", status)
+	}
+}
+
+func TestSystemSharedMemory(t *testing.T) {
+	regions := make(map[string]*triton.SystemSharedMemoryStatusResponse_RegionStatus)
+	client := startFakeServer(t, &fakeServer{
+		systemRegister: func(_ context.Context, req *triton.SystemSharedMemoryRegisterRequest) (*triton.SystemSharedMemoryRegisterResponse, error) {
+			regions[req.Name] = &triton.SystemSharedMemoryStatusResponse_RegionStatus{
+				Name:     req.Name,
+				Key:      req.Key,
+				Offset:   req.Offset,
+				ByteSize: req.ByteSize,
+			}
+			return &triton.SystemSharedMemoryRegisterResponse{}, nil
+		},
+		systemUnregister: func(_ context.Context, req *triton.SystemSharedMemoryUnregisterRequest) (*triton.SystemSharedMemoryUnregisterResponse, error) {
+			if req.Name == "" {
+				regions = make(map[string]*triton.SystemSharedMemoryStatusResponse_RegionStatus)
+			}
+			delete(regions, req.Name)
+			return &triton.SystemSharedMemoryUnregisterResponse{}, nil
+		},
+		systemStatus: func(context.Context, *triton.SystemSharedMemoryStatusRequest) (*triton.SystemSharedMemoryStatusResponse, error) {
+			return &triton.SystemSharedMemoryStatusResponse{Regions: regions}, nil
+		},
+	})
+	ctx := context.Background()
+
+	if err := client.RegisterSystemSharedMemory(ctx, "images", "/images", 128, 4096); err != nil {
+		t.Fatalf("RegisterSystemSharedMemory: %v", err)
+	}
+	status, err := client.SystemSharedMemoryStatus(ctx, "")
+	if err != nil {
+		t.Fatalf("SystemSharedMemoryStatus: %v", err)
+	}
+	if region := status["images"]; region == nil || region.Key != "/images" || region.Offset != 128 || region.ByteSize != 4096 {
+		t.Errorf("images status = %v", region)
+	}
+
+	if err := client.UnregisterSystemSharedMemory(ctx, ""); err != nil {
+		t.Fatalf("UnregisterSystemSharedMemory: %v", err)
+	}
+	if status, _ := client.SystemSharedMemoryStatus(ctx, ""); len(status) != 0 {
+		t.Errorf("regions after unregister = %v", status)
+	}
+}
+
+func TestSharedMemoryInput(t *testing.T) {
+	req, err := NewRequestBuilder("simple", "").
+		WithSharedMemoryInput("INPUT0", "INT32", []int64{1, 16}, "input_data", 64, 0).
+		WithInput("INPUT1", "INT32", []int64{1}, []int32{1}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	params := req.Inputs[0].Parameters
+	if got := params["shared_memory_region"].GetStringParam(); got != "input_data" {
+		t.Errorf("shared_memory_region = %q", got)
+	}
+	if got := params["shared_memory_byte_size"].GetInt64Param(); got != 64 {
+		t.Errorf("shared_memory_byte_size = %d", got)
+	}
+	if _, ok := params["shared_memory_offset"]; ok {
+		t.Error("zero offset was sent")
+	}
+	if len(req.RawInputContents) != 1 {
+		t.Errorf("got %d raw input contents, want 1", len(req.RawInputContents))
+	}
+}
+
+func TestSharedMemoryOutput(t *testing.T) {
+	req, err := NewRequestBuilder("simple", "").
+		WithInput("INPUT0", "INT32", []int64{1}, []int32{1}).
+		WithSharedMemoryOutput("OUTPUT0", "output_data", 64, 256).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	params := req.Outputs[0].Parameters
+	if got := params["shared_memory_region"].GetStringParam(); got != "output_data" {
+		t.Errorf("shared_memory_region = %q", got)
+	}
+	if got := params["shared_memory_offset"].GetInt64Param(); got != 256 {
+		t.Errorf("shared_memory_offset = %d", got)
+	}
+}
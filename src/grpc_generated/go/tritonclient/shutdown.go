@@ -0,0 +1,140 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// ErrShutdown is returned for calls started after Shutdown.
+var ErrShutdown = errors.New("client is shut down")
+
+// Shutdown stops the client accepting new calls, which fail with
+// ErrShutdown, waits for calls in flight to finish and then closes the
+// connection. If ctx is done first the connection is closed anyway,
+// failing the remaining calls, and ctx's error is returned. A stream
+// counts as in flight until a receive on it fails, as it does once the
+// server ends it or its context is cancelled.
+func (c *TritonClient) Shutdown(ctx context.Context) error {
+	idle := c.calls.drain()
+	var err error
+	select {
+	case <-idle:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	if closeErr := c.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// callTracker counts the calls in flight so Shutdown can wait for them.
+type callTracker struct {
+	mu       sync.Mutex
+	n        int
+	draining bool
+	idle     chan struct{}
+}
+
+func newCallTracker() *callTracker {
+	return &callTracker{idle: make(chan struct{})}
+}
+
+// start registers a new call, unless the client is draining.
+func (t *callTracker) start() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.draining {
+		return ErrShutdown
+	}
+	t.n++
+	return nil
+}
+
+func (t *callTracker) finish() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.n--
+	if t.draining && t.n == 0 {
+		close(t.idle)
+	}
+}
+
+// drain refuses further calls and returns a channel closed once none are
+// in flight.
+func (t *callTracker) drain() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.draining {
+		t.draining = true
+		if t.n == 0 {
+			close(t.idle)
+		}
+	}
+	return t.idle
+}
+
+func (t *callTracker) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := t.start(); err != nil {
+		return err
+	}
+	defer t.finish()
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (t *callTracker) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := t.start(); err != nil {
+		return nil, err
+	}
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		t.finish()
+		return nil, err
+	}
+	return &trackedStream{ClientStream: stream, finish: t.finish}, nil
+}
+
+// trackedStream reports the end of the stream to its tracker the first
+// time a receive fails.
+type trackedStream struct {
+	grpc.ClientStream
+	once   sync.Once
+	finish func()
+}
+
+func (s *trackedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(s.finish)
+	}
+	return err
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestShutdownWaitsForInFlight(t *testing.T) {
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			close(entered)
+			<-release
+			return &triton.ModelInferResponse{Id: "slow"}, nil
+		},
+	})
+
+	inferErr := make(chan error, 1)
+	go func() {
+		_, err := client.Infer(context.Background(), &triton.ModelInferRequest{})
+		inferErr <- err
+	}()
+	<-entered
+
+	shutdownErr := make(chan error, 1)
+	go func() { shutdownErr <- client.Shutdown(context.Background()) }()
+	// Wait for Shutdown to start refusing calls.
+	for {
+		_, err := client.Live(context.Background())
+		if errors.Is(err, ErrShutdown) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	select {
+	case err := <-shutdownErr:
+		t.Fatalf("Shutdown returned %v with a call in flight", err)
+	default:
+	}
+
+	close(release)
+	if err := <-inferErr; err != nil {
+		t.Errorf("in-flight Infer: %v", err)
+	}
+	if err := <-shutdownErr; err != nil {
+		t.Errorf("Shutdown: %v", err)
+	}
+}
+
+func TestShutdownDeadline(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	entered := make(chan struct{})
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(context.Context, *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			close(entered)
+			<-release
+			return &triton.ModelInferResponse{}, nil
+		},
+	})
+
+	inferErr := make(chan error, 1)
+	go func() {
+		_, err := client.Infer(context.Background(), &triton.ModelInferRequest{})
+		inferErr <- err
+	}()
+	<-entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := client.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown = %v, want DeadlineExceeded", err)
+	}
+	if err := <-inferErr; err == nil {
+		t.Error("Infer cut off by Shutdown succeeded")
+	}
+}
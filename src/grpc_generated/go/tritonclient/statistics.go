@@ -0,0 +1,128 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+// Statistics returns the inference statistics of a model version. An
+// empty version covers every version of the model, and an empty name
+// every model.
+func (c *TritonClient) Statistics(ctx context.Context, name, version string) (*triton.ModelStatisticsResponse, error) {
+	version, err := normalizeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	return c.client.ModelStatistics(ctx, &triton.ModelStatisticsRequest{Name: name, Version: version})
+}
+
+// ModelLatency summarizes the statistics of one model version. The server
+// only reports cumulative durations, so these are averages since the
+// model was loaded.
+type ModelLatency struct {
+	Name           string
+	Version        string
+	InferenceCount uint64
+	ExecutionCount uint64
+	// AvgQueue is the average time a request waited to be scheduled.
+	AvgQueue time.Duration
+	// AvgCompute is the average time spent preparing inputs, executing
+	// the model and extracting outputs.
+	AvgCompute time.Duration
+}
+
+// AverageLatencies computes the average queue and compute latency of each
+// model in resp. Models that have never been invoked report zero.
+func AverageLatencies(resp *triton.ModelStatisticsResponse) []ModelLatency {
+	latencies := make([]ModelLatency, 0, len(resp.GetModelStats()))
+	for _, model := range resp.GetModelStats() {
+		stats := model.GetInferenceStats()
+		compute := stats.GetComputeInput().GetNs() + stats.GetComputeInfer().GetNs() + stats.GetComputeOutput().GetNs()
+		latencies = append(latencies, ModelLatency{
+			Name:           model.Name,
+			Version:        model.Version,
+			InferenceCount: model.InferenceCount,
+			ExecutionCount: model.ExecutionCount,
+			AvgQueue:       averageDuration(stats.GetQueue().GetNs(), stats.GetQueue().GetCount()),
+			AvgCompute:     averageDuration(compute, stats.GetComputeInfer().GetCount()),
+		})
+	}
+	return latencies
+}
+
+// CacheStats summarizes how often the server's response cache answered
+// for one model version.
+type CacheStats struct {
+	Name    string
+	Version string
+	Hits    uint64
+	Misses  uint64
+	// AvgHitLookup is the average time spent retrieving a cached response.
+	AvgHitLookup time.Duration
+}
+
+// HitRate returns the fraction of cache lookups that hit, or zero if
+// there were none.
+func (s CacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// ResponseCacheStats extracts the response cache counts of each model in
+// resp. Triton does not mark individual responses served from its cache,
+// neither in the response parameters nor elsewhere in ModelInferResponse,
+// so cache effectiveness can only be measured from these statistics.
+// Models without response_cache enabled in their config report zero.
+func ResponseCacheStats(resp *triton.ModelStatisticsResponse) []CacheStats {
+	stats := make([]CacheStats, 0, len(resp.GetModelStats()))
+	for _, model := range resp.GetModelStats() {
+		infer := model.GetInferenceStats()
+		stats = append(stats, CacheStats{
+			Name:         model.Name,
+			Version:      model.Version,
+			Hits:         infer.GetCacheHit().GetCount(),
+			Misses:       infer.GetCacheMiss().GetCount(),
+			AvgHitLookup: averageDuration(infer.GetCacheHit().GetNs(), infer.GetCacheHit().GetCount()),
+		})
+	}
+	return stats
+}
+
+func averageDuration(totalNs, count uint64) time.Duration {
+	if count == 0 {
+		return 0
+	}
+	return time.Duration(totalNs / count)
+}
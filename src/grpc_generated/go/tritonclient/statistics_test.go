@@ -0,0 +1,102 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestStatistics(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		modelStatistics: func(_ context.Context, req *triton.ModelStatisticsRequest) (*triton.ModelStatisticsResponse, error) {
+			return &triton.ModelStatisticsResponse{ModelStats: []*triton.ModelStatistics{
+				{
+					Name:           req.Name,
+					Version:        "1",
+					InferenceCount: 4,
+					ExecutionCount: 2,
+					InferenceStats: &triton.InferStatistics{
+						Queue:         &triton.StatisticDuration{Count: 4, Ns: 4000},
+						ComputeInput:  &triton.StatisticDuration{Count: 4, Ns: 400},
+						ComputeInfer:  &triton.StatisticDuration{Count: 4, Ns: 8000},
+						ComputeOutput: &triton.StatisticDuration{Count: 4, Ns: 400},
+					},
+				},
+				// A model that has been loaded but never invoked.
+				{Name: "idle", Version: "1", InferenceStats: &triton.InferStatistics{}},
+			}}, nil
+		},
+	})
+
+	resp, err := client.Statistics(context.Background(), "simple", "")
+	if err != nil {
+		t.Fatalf("Statistics: %v", err)
+	}
+	latencies := AverageLatencies(resp)
+	if len(latencies) != 2 {
+		t.Fatalf("got %d latencies, want 2", len(latencies))
+	}
+	want := ModelLatency{Name: "simple", Version: "1", InferenceCount: 4, ExecutionCount: 2, AvgQueue: time.Microsecond, AvgCompute: 2200 * time.Nanosecond}
+	if latencies[0] != want {
+		t.Errorf("simple = %+v, want %+v", latencies[0], want)
+	}
+	if idle := latencies[1]; idle.AvgQueue != 0 || idle.AvgCompute != 0 {
+		t.Errorf("idle = %+v, want zero latencies", idle)
+	}
+}
+
+func TestResponseCacheStats(t *testing.T) {
+	stats := ResponseCacheStats(&triton.ModelStatisticsResponse{ModelStats: []*triton.ModelStatistics{
+		{
+			Name:    "simple",
+			Version: "1",
+			InferenceStats: &triton.InferStatistics{
+				CacheHit:  &triton.StatisticDuration{Count: 3, Ns: 3000},
+				CacheMiss: &triton.StatisticDuration{Count: 1, Ns: 5000},
+			},
+		},
+		{Name: "uncached", Version: "1", InferenceStats: &triton.InferStatistics{}},
+	}})
+
+	if len(stats) != 2 {
+		t.Fatalf("got %d stats, want 2", len(stats))
+	}
+	want := CacheStats{Name: "simple", Version: "1", Hits: 3, Misses: 1, AvgHitLookup: time.Microsecond}
+	if stats[0] != want {
+		t.Errorf("stats = %+v, want %+v", stats[0], want)
+	}
+	if rate := stats[0].HitRate(); rate != 0.75 {
+		t.Errorf("HitRate = %v, want 0.75", rate)
+	}
+	if rate := stats[1].HitRate(); rate != 0 {
+		t.Errorf("HitRate without lookups = %v, want 0", rate)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	triton "nvidia_inferenceserver"
+)
+
+// InferStream is a bidirectional ModelStreamInfer call. Requests may be
+// sent while responses are being received.
+type InferStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	stream triton.GRPCInferenceService_ModelStreamInferClient
+
+	sendMu sync.Mutex
+	recvMu sync.Mutex
+	done   chan struct{}
+}
+
+// StreamInfer opens a ModelStreamInfer call. Cancelling ctx aborts the
+// call; Close must be called once the stream is no longer needed.
+func (c *TritonClient) StreamInfer(ctx context.Context) (*InferStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.client.ModelStreamInfer(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	s := &InferStream{ctx: ctx, cancel: cancel, stream: stream, done: make(chan struct{})}
+	go s.cleanup()
+	return s, nil
+}
+
+// cleanup waits for the call to be cancelled, then half-closes it and
+// drains it until Recv fails, so nothing is left blocked on the stream.
+func (s *InferStream) cleanup() {
+	defer close(s.done)
+	<-s.ctx.Done()
+
+	s.sendMu.Lock()
+	s.stream.CloseSend()
+	s.sendMu.Unlock()
+
+	s.recvMu.Lock()
+	defer s.recvMu.Unlock()
+	for {
+		if _, err := s.stream.Recv(); err != nil {
+			return
+		}
+	}
+}
+
+// Send sends req on the stream. Once the stream's context is done, Send
+// returns the context's error without sending.
+func (s *InferStream) Send(req *triton.ModelInferRequest) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	err := s.stream.Send(req)
+	if errors.Is(err, io.EOF) {
+		// The server ended the call; Recv reports why.
+		return io.EOF
+	}
+	return err
+}
+
+// InferResponseError is the error Recv returns when the server reports
+// that a single request on the stream failed. The stream itself remains
+// usable, so Recv can be called again; io.EOF, cancellation and transport
+// errors are never InferResponseErrors.
+type InferResponseError struct {
+	Message string
+	// Response is the response the message arrived with, if any. It may
+	// carry the id of the failed request.
+	Response *triton.ModelInferResponse
+}
+
+func (e *InferResponseError) Error() string {
+	return e.Message
+}
+
+// Recv receives the next response. If the server attached an error
+// message to it, Recv returns an *InferResponseError instead; use
+// errors.As to tell a failed request from a failed stream. Recv returns
+// io.EOF once the server has closed the stream, and the context's error
+// if the call was cancelled.
+func (s *InferStream) Recv() (*triton.ModelInferResponse, error) {
+	s.recvMu.Lock()
+	resp, err := s.stream.Recv()
+	s.recvMu.Unlock()
+	if err != nil {
+		if !errors.Is(err, io.EOF) && s.ctx.Err() != nil {
+			return nil, s.ctx.Err()
+		}
+		return nil, err
+	}
+	if msg := resp.GetErrorMessage(); msg != "" {
+		return nil, &InferResponseError{Message: msg, Response: resp.GetInferResponse()}
+	}
+	return resp.GetInferResponse(), nil
+}
+
+// CloseSend half-closes the stream, telling the server no more requests
+// will be sent. Responses can still be received until Recv returns
+// io.EOF.
+func (s *InferStream) CloseSend() error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.stream.CloseSend()
+}
+
+// Close aborts the call and waits until its resources are released.
+func (s *InferStream) Close() {
+	s.cancel()
+	<-s.done
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	triton "nvidia_inferenceserver"
+)
+
+// IsFinalResponse reports whether resp carries the "triton_final_response"
+// parameter a decoupled model sets on the last response for a request.
+func IsFinalResponse(resp *triton.ModelInferResponse) bool {
+	return resp.GetParameters()["triton_final_response"].GetBoolParam()
+}
+
+// ReceiveStreamTokens reads responses from stream, decodes the named BYTES
+// output of each and passes its elements to fn as soon as they arrive. It
+// returns when the final response is seen, which may carry no outputs, or
+// when the server closes the stream. An error returned by fn stops
+// receiving.
+func ReceiveStreamTokens(stream *InferStream, output string, fn func(token string) error) error {
+	for {
+		infer, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if infer == nil {
+			continue
+		}
+		if raw, ok := NewInferResult(infer).Raw(output); ok {
+			tokens, err := DecodeBytes(raw)
+			if err != nil {
+				return fmt.Errorf("output %s: %w", output, err)
+			}
+			for _, token := range tokens {
+				if err := fn(token); err != nil {
+					return err
+				}
+			}
+		}
+		if IsFinalResponse(infer) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestReceiveStreamTokens(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{
+		modelStreamInfer: func(stream triton.GRPCInferenceService_ModelStreamInferServer) error {
+			if _, err := stream.Recv(); err != nil {
+				return err
+			}
+			for _, token := range []string{"Hello", ", ", "wörld"} {
+				raw, _ := EncodeTensor("BYTES", []string{token})
+				stream.Send(&triton.ModelStreamInferResponse{InferResponse: &triton.ModelInferResponse{
+					Outputs:           []*triton.ModelInferResponse_InferOutputTensor{{Name: "text_output", Datatype: "BYTES", Shape: []int64{1}}},
+					RawOutputContents: [][]byte{raw},
+				}})
+			}
+			stream.Send(&triton.ModelStreamInferResponse{InferResponse: &triton.ModelInferResponse{
+				Parameters: map[string]*triton.InferParameter{
+					"triton_final_response": {ParameterChoice: &triton.InferParameter_BoolParam{BoolParam: true}},
+				},
+			}})
+			// Block until the client goes away so only the final
+			// response can end the receive loop.
+			<-stream.Context().Done()
+			return nil
+		},
+	})
+
+	stream, err := client.StreamInfer(context.Background())
+	if err != nil {
+		t.Fatalf("StreamInfer: %v", err)
+	}
+	defer stream.Close()
+	if err := stream.Send(&triton.ModelInferRequest{ModelName: "llm"}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	var text string
+	err = ReceiveStreamTokens(stream, "text_output", func(token string) error {
+		text += token
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ReceiveStreamTokens: %v", err)
+	}
+	if text != "Hello, wörld" {
+		t.Errorf("received %q, want %q", text, "Hello, wörld")
+	}
+}
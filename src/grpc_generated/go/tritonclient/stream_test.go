@@ -0,0 +1,162 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+)
+
+// echoStreamServer answers each request with a response carrying its id,
+// or an error message for requests without one, until the client
+// half-closes.
+func echoStreamServer() *fakeServer {
+	return &fakeServer{
+		modelStreamInfer: func(stream triton.GRPCInferenceService_ModelStreamInferServer) error {
+			for {
+				req, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				resp := &triton.ModelStreamInferResponse{InferResponse: &triton.ModelInferResponse{Id: req.Id}}
+				if req.Id == "" {
+					resp = &triton.ModelStreamInferResponse{ErrorMessage: "request has no id"}
+				}
+				if err := stream.Send(resp); err != nil {
+					return err
+				}
+			}
+		},
+	}
+}
+
+func TestStreamInfer(t *testing.T) {
+	client := startFakeServer(t, echoStreamServer())
+	stream, err := client.StreamInfer(context.Background())
+	if err != nil {
+		t.Fatalf("StreamInfer: %v", err)
+	}
+	defer stream.Close()
+
+	for _, id := range []string{"1", "", "3"} {
+		if err := stream.Send(&triton.ModelInferRequest{ModelName: "seq", Id: id}); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend: %v", err)
+	}
+
+	var ids, errMsgs []string
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		var respErr *InferResponseError
+		if errors.As(err, &respErr) {
+			errMsgs = append(errMsgs, respErr.Message)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		ids = append(ids, resp.Id)
+	}
+	if len(ids) != 2 || ids[0] != "1" || ids[1] != "3" {
+		t.Errorf("response ids = %q, want [1 3]", ids)
+	}
+	if len(errMsgs) != 1 || errMsgs[0] != "request has no id" {
+		t.Errorf("error messages = %q", errMsgs)
+	}
+}
+
+func TestStreamInferCancel(t *testing.T) {
+	client := startFakeServer(t, echoStreamServer())
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.StreamInfer(ctx)
+	if err != nil {
+		t.Fatalf("StreamInfer: %v", err)
+	}
+	defer stream.Close()
+
+	cancel()
+	if err := stream.Send(&triton.ModelInferRequest{Id: "1"}); err != context.Canceled {
+		t.Errorf("Send after cancel: %v, want context.Canceled", err)
+	}
+	if _, err := stream.Recv(); err != context.Canceled {
+		t.Errorf("Recv after cancel: %v, want context.Canceled", err)
+	}
+}
+
+func TestStreamInferCancelReleasesGoroutines(t *testing.T) {
+	client := startFakeServer(t, echoStreamServer())
+	// Connect first so the connection's goroutines count in the baseline.
+	client.Live(context.Background())
+	baseline := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.StreamInfer(ctx)
+	if err != nil {
+		t.Fatalf("StreamInfer: %v", err)
+	}
+	if err := stream.Send(&triton.ModelInferRequest{Id: "1"}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	received := make(chan error)
+	go func() {
+		for {
+			if _, err := stream.Recv(); err != nil {
+				received <- err
+				return
+			}
+		}
+	}()
+
+	cancel()
+	if err := <-received; err != context.Canceled {
+		t.Errorf("Recv after cancel: %v, want context.Canceled", err)
+	}
+	stream.Close()
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > baseline {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines after cancel, baseline %d", runtime.NumGoroutine(), baseline)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// WithTLSConfig dials the server over TLS using cfg.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = cfg
+	}
+}
+
+// WithMinTLSVersion rejects connections that negotiate a TLS version below
+// version (e.g. tls.VersionTLS12). It implies TLS.
+func WithMinTLSVersion(version uint16) Option {
+	return func(o *options) {
+		if o.tlsConfig == nil {
+			o.tlsConfig = &tls.Config{}
+		}
+		o.minTLSVersion = version
+	}
+}
+
+// WithCipherSuites restricts the cipher suites offered for TLS 1.2 and
+// below. TLS 1.3 suites are not configurable in crypto/tls, so combined
+// with WithMinTLSVersion(tls.VersionTLS13) this has no effect. It implies
+// TLS.
+func WithCipherSuites(suites ...uint16) Option {
+	return func(o *options) {
+		if o.tlsConfig == nil {
+			o.tlsConfig = &tls.Config{}
+		}
+		o.cipherSuites = suites
+	}
+}
+
+// WithTLSFiles dials the server over TLS using PEM files. certFile and
+// keyFile hold the client certificate presented for mutual TLS and may
+// both be empty; caFile holds the CAs trusted to sign the server
+// certificate and defaults to the system roots when empty. The files are
+// read by NewTritonClient and applied on top of any WithTLSConfig.
+func WithTLSFiles(certFile, keyFile, caFile string) Option {
+	return func(o *options) {
+		o.tlsFiles = &tlsFiles{cert: certFile, key: keyFile, ca: caFile}
+	}
+}
+
+// WithTLSServerName sets the name the server certificate is verified
+// against, and sent as SNI, when it differs from the host being dialed.
+// Only the certificate's subject alternative names are matched. It
+// implies TLS.
+func WithTLSServerName(name string) Option {
+	return func(o *options) {
+		o.tlsServerName = name
+	}
+}
+
+type tlsFiles struct {
+	cert string
+	key  string
+	ca   string
+}
+
+// apply loads the files into cfg.
+func (f *tlsFiles) apply(cfg *tls.Config) error {
+	if (f.cert == "") != (f.key == "") {
+		return errors.New("client certificate and key files must be given together")
+	}
+	if f.cert != "" {
+		cert, err := tls.LoadX509KeyPair(f.cert, f.key)
+		if err != nil {
+			return fmt.Errorf("couldn't load client certificate: %w", err)
+		}
+		cfg.Certificates = append(cfg.Certificates, cert)
+	}
+	if f.ca != "" {
+		pem, err := os.ReadFile(f.ca)
+		if err != nil {
+			return fmt.Errorf("couldn't read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in CA file %s", f.ca)
+		}
+		cfg.RootCAs = pool
+	}
+	return nil
+}
+
+// buildTLSConfig combines the TLS options into one config, or returns nil
+// when the connection should be insecure.
+func (o *options) buildTLSConfig() (*tls.Config, error) {
+	if o.tlsConfig == nil && o.tlsFiles == nil && o.tlsServerName == "" {
+		return nil, nil
+	}
+	cfg := &tls.Config{}
+	if o.tlsConfig != nil {
+		cfg = o.tlsConfig.Clone()
+	}
+	if o.tlsFiles != nil {
+		if err := o.tlsFiles.apply(cfg); err != nil {
+			return nil, err
+		}
+	}
+	if o.tlsServerName != "" {
+		cfg.ServerName = o.tlsServerName
+	}
+	if len(o.cipherSuites) > 0 {
+		cfg.CipherSuites = o.cipherSuites
+	}
+	return cfg, nil
+}
+
+// TLSConnectionState returns the version and cipher suite negotiated by the
+// most recent TLS handshake. ok is false for insecure connections and until
+// the first handshake completes.
+func (c *TritonClient) TLSConnectionState() (state tls.ConnectionState, ok bool) {
+	if c.creds == nil {
+		return tls.ConnectionState{}, false
+	}
+	return c.creds.negotiated.get()
+}
+
+type negotiatedState struct {
+	mu    sync.Mutex
+	state *tls.ConnectionState
+}
+
+func (n *negotiatedState) get() (tls.ConnectionState, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.state == nil {
+		return tls.ConnectionState{}, false
+	}
+	return *n.state, true
+}
+
+func (n *negotiatedState) set(state tls.ConnectionState) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.state = &state
+}
+
+// tlsCredentials records the negotiated connection state and re-checks the
+// minimum version after every handshake, so a caller-supplied tls.Config
+// with a lower MinVersion cannot weaken the policy.
+type tlsCredentials struct {
+	credentials.TransportCredentials
+	minVersion uint16
+	negotiated *negotiatedState
+}
+
+func newTLSCredentials(cfg *tls.Config, minVersion uint16) *tlsCredentials {
+	cfg = cfg.Clone()
+	if minVersion > cfg.MinVersion {
+		cfg.MinVersion = minVersion
+	}
+	return &tlsCredentials{
+		TransportCredentials: credentials.NewTLS(cfg),
+		minVersion:           cfg.MinVersion,
+		negotiated:           &negotiatedState{},
+	}
+}
+
+func (c *tlsCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn, authInfo, err := c.TransportCredentials.ClientHandshake(ctx, authority, rawConn)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, ok := authInfo.(credentials.TLSInfo)
+	if !ok {
+		conn.Close()
+		return nil, nil, fmt.Errorf("unexpected auth info type %T", authInfo)
+	}
+	if info.State.Version < c.minVersion {
+		conn.Close()
+		return nil, nil, fmt.Errorf("negotiated %s is below the minimum %s",
+			tls.VersionName(info.State.Version), tls.VersionName(c.minVersion))
+	}
+	c.negotiated.set(info.State)
+	return conn, authInfo, nil
+}
+
+func (c *tlsCredentials) Clone() credentials.TransportCredentials {
+	return &tlsCredentials{
+		TransportCredentials: c.TransportCredentials.Clone(),
+		minVersion:           c.minVersion,
+		negotiated:           c.negotiated,
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// testCert is a certificate signed by a test CA, written to PEM files.
+type testCert struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certFile string
+	keyFile  string
+}
+
+func newTestCert(t *testing.T, dir, name string, template *x509.Certificate, parent *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &testCert{cert: cert, key: key, certFile: filepath.Join(dir, name+".pem"), keyFile: filepath.Join(dir, name+"-key.pem")}
+	if err := os.WriteFile(c.certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(c.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+	// The server certificate names its host only in a SAN, not the CN.
+	server := newTestCert(t, dir, "server", &x509.Certificate{
+		DNSNames:    []string{"triton.test"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	client := newTestCert(t, dir, "client", &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "client"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+
+	serverCert, err := tls.LoadX509KeyPair(server.certFile, server.keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})))
+	triton.RegisterGRPCInferenceServiceServer(s, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	tc, err := NewTritonClient(lis.Addr().String(),
+		WithTLSFiles(client.certFile, client.keyFile, ca.certFile),
+		WithTLSServerName("triton.test"))
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	defer tc.Close()
+
+	live, err := tc.Live(context.Background())
+	if err != nil || !live {
+		t.Fatalf("Live = %v, %v", live, err)
+	}
+	if _, ok := tc.TLSConnectionState(); !ok {
+		t.Error("no TLS connection state after a call")
+	}
+
+	// Without the client certificate the server rejects the handshake.
+	noCert, err := NewTritonClient(lis.Addr().String(), WithTLSFiles("", "", ca.certFile), WithTLSServerName("triton.test"))
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	defer noCert.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := noCert.Live(ctx); err == nil {
+		t.Error("Live succeeded without a client certificate")
+	}
+}
+
+func TestTLSFilesErrors(t *testing.T) {
+	if _, err := NewTritonClient("localhost:8001", WithTLSFiles("cert.pem", "", "")); err == nil {
+		t.Error("NewTritonClient accepted a certificate without a key")
+	}
+	if _, err := NewTritonClient("localhost:8001", WithTLSFiles("", "", filepath.Join(t.TempDir(), "missing.pem"))); err == nil {
+		t.Error("NewTritonClient accepted a missing CA file")
+	}
+}
+
+// startTLS12Server serves Live over TLS 1.2 only, with a certificate for
+// triton.test signed by the returned CA pool.
+func startTLS12Server(t *testing.T) (addr string, roots *x509.CertPool) {
+	t.Helper()
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+	server := newTestCert(t, dir, "server", &x509.Certificate{
+		DNSNames:    []string{"triton.test"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	serverCert, err := tls.LoadX509KeyPair(server.certFile, server.keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		MaxVersion:   tls.VersionTLS12,
+	})))
+	triton.RegisterGRPCInferenceServiceServer(s, &fakeServer{
+		serverLive: func(context.Context, *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	roots = x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	return lis.Addr().String(), roots
+}
+
+func TestMinTLSVersion(t *testing.T) {
+	addr, roots := startTLS12Server(t)
+
+	tc, err := NewTritonClient(addr,
+		WithTLSConfig(&tls.Config{RootCAs: roots}),
+		WithTLSServerName("triton.test"),
+		WithMinTLSVersion(tls.VersionTLS12))
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	defer tc.Close()
+	if live, err := tc.Live(context.Background()); err != nil || !live {
+		t.Fatalf("Live with a TLS 1.2 minimum = %v, %v", live, err)
+	}
+	if state, ok := tc.TLSConnectionState(); !ok || state.Version != tls.VersionTLS12 {
+		t.Errorf("TLSConnectionState = %s, %v, want TLS 1.2", tls.VersionName(state.Version), ok)
+	}
+
+	// A TLS 1.2-only server can't satisfy a TLS 1.3 minimum, even when the
+	// supplied config allows older versions.
+	strict, err := NewTritonClient(addr,
+		WithTLSConfig(&tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS10}),
+		WithTLSServerName("triton.test"),
+		WithMinTLSVersion(tls.VersionTLS13))
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	defer strict.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := strict.Live(ctx); err == nil {
+		t.Error("Live succeeded against a TLS 1.2-only server with a TLS 1.3 minimum")
+	}
+	if _, ok := strict.TLSConnectionState(); ok {
+		t.Error("TLS connection state recorded for a rejected handshake")
+	}
+}
+
+func TestCipherSuites(t *testing.T) {
+	addr, roots := startTLS12Server(t)
+
+	suite := tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256
+	tc, err := NewTritonClient(addr,
+		WithTLSConfig(&tls.Config{RootCAs: roots}),
+		WithTLSServerName("triton.test"),
+		WithCipherSuites(suite))
+	if err != nil {
+		t.Fatalf("NewTritonClient: %v", err)
+	}
+	defer tc.Close()
+	if live, err := tc.Live(context.Background()); err != nil || !live {
+		t.Fatalf("Live = %v, %v", live, err)
+	}
+	if state, _ := tc.TLSConnectionState(); state.CipherSuite != suite {
+		t.Errorf("negotiated %s, want %s", tls.CipherSuiteName(state.CipherSuite), tls.CipherSuiteName(suite))
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+
+	triton "nvidia_inferenceserver"
+)
+
+// Trace setting names understood by the server. Each setting holds a list
+// of values; trace_level, for instance, may combine TIMESTAMPS and
+// TENSORS.
+const (
+	TraceLevel        = "trace_level"
+	TraceRate         = "trace_rate"
+	TraceCount        = "trace_count"
+	TraceFile         = "trace_file"
+	TraceLogFrequency = "log_frequency"
+)
+
+// TraceSettings maps trace setting names to their values.
+type TraceSettings map[string][]string
+
+// TraceSettings returns the trace settings of the named model, or the
+// global settings if model is empty. It fails with an error wrapping
+// ErrUnsupportedExtension if the server doesn't advertise the trace
+// extension.
+func (c *TritonClient) TraceSettings(ctx context.Context, model string) (TraceSettings, error) {
+	return c.UpdateTraceSettings(ctx, model, nil)
+}
+
+// UpdateTraceSettings changes the given trace settings of the named model,
+// or the global settings if model is empty, and returns the settings now
+// in effect. Settings not mentioned are left alone; a setting with no
+// values reverts a model to the global value. This lets tracing be turned
+// on for a sample of traffic, via TraceRate, without restarting the
+// server.
+func (c *TritonClient) UpdateTraceSettings(ctx context.Context, model string, settings TraceSettings) (TraceSettings, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	if err := c.requireExtension(ctx, "trace"); err != nil {
+		return nil, err
+	}
+
+	req := &triton.TraceSettingRequest{ModelName: model}
+	if len(settings) > 0 {
+		req.Settings = make(map[string]*triton.TraceSettingRequest_SettingValue, len(settings))
+		for name, values := range settings {
+			req.Settings[name] = &triton.TraceSettingRequest_SettingValue{Value: values}
+		}
+	}
+	resp, err := c.client.TraceSetting(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	current := make(TraceSettings, len(resp.Settings))
+	for name, value := range resp.Settings {
+		current[name] = value.GetValue()
+	}
+	return current, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTraceIDs(t *testing.T) {
+	var got []string
+	client := startFakeServer(t, &fakeServer{
+		serverLive: func(ctx context.Context, _ *triton.ServerLiveRequest) (*triton.ServerLiveResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			got = append(got, md.Get(TraceIDMetadataKey)...)
+			return &triton.ServerLiveResponse{Live: true}, nil
+		},
+	}, WithTraceIDs())
+
+	ctx := context.Background()
+	client.GRPCClient().ServerLive(ctx, &triton.ServerLiveRequest{})
+	client.GRPCClient().ServerLive(ContextWithTraceID(ctx, "upstream-id"), &triton.ServerLiveRequest{})
+
+	if len(got) != 2 {
+		t.Fatalf("server saw trace ids %v, want 2", got)
+	}
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if !uuid.MatchString(got[0]) {
+		t.Errorf("generated trace id %q is not a UUID", got[0])
+	}
+	if got[1] != "upstream-id" {
+		t.Errorf("trace id from context = %q, want upstream-id", got[1])
+	}
+}
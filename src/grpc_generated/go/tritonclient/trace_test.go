@@ -0,0 +1,87 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+// withExtensions returns a ServerMetadata hook advertising extensions.
+func withExtensions(extensions ...string) func(context.Context, *triton.ServerMetadataRequest) (*triton.ServerMetadataResponse, error) {
+	return func(context.Context, *triton.ServerMetadataRequest) (*triton.ServerMetadataResponse, error) {
+		return &triton.ServerMetadataResponse{Name: "triton", Version: "2.40.0", Extensions: extensions}, nil
+	}
+}
+
+func TestUpdateTraceSettings(t *testing.T) {
+	var got *triton.TraceSettingRequest
+	client := startFakeServer(t, &fakeServer{
+		serverMetadata: withExtensions("trace"),
+		traceSetting: func(_ context.Context, req *triton.TraceSettingRequest) (*triton.TraceSettingResponse, error) {
+			got = req
+			resp := &triton.TraceSettingResponse{Settings: map[string]*triton.TraceSettingResponse_SettingValue{
+				TraceLevel: {Value: []string{"TIMESTAMPS"}},
+				TraceRate:  {Value: []string{"1000"}},
+			}}
+			for name, value := range req.Settings {
+				resp.Settings[name] = &triton.TraceSettingResponse_SettingValue{Value: value.Value}
+			}
+			return resp, nil
+		},
+	})
+
+	settings, err := client.UpdateTraceSettings(context.Background(), "simple", TraceSettings{TraceRate: {"100"}})
+	if err != nil {
+		t.Fatalf("UpdateTraceSettings: %v", err)
+	}
+	if got.ModelName != "simple" || !reflect.DeepEqual(got.Settings[TraceRate].GetValue(), []string{"100"}) {
+		t.Errorf("server got %v", got)
+	}
+	want := TraceSettings{TraceLevel: {"TIMESTAMPS"}, TraceRate: {"100"}}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("settings = %v, want %v", settings, want)
+	}
+
+	if _, err := client.TraceSettings(context.Background(), ""); err != nil {
+		t.Fatalf("TraceSettings: %v", err)
+	}
+	if got.ModelName != "" || len(got.Settings) != 0 {
+		t.Errorf("TraceSettings sent %v, want an empty request", got)
+	}
+}
+
+func TestTraceSettingsUnsupported(t *testing.T) {
+	client := startFakeServer(t, &fakeServer{serverMetadata: withExtensions("statistics")})
+	if _, err := client.TraceSettings(context.Background(), ""); !errors.Is(err, ErrUnsupportedExtension) {
+		t.Errorf("TraceSettings error = %v, want ErrUnsupportedExtension", err)
+	}
+}
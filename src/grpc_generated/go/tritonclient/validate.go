@@ -0,0 +1,137 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"fmt"
+
+	triton "nvidia_inferenceserver"
+)
+
+// ValidateRawInputContents checks that req carries one RawInputContents
+// entry per input that isn't read from shared memory. The server pairs
+// them up by position, so a mismatch silently corrupts every input. A
+// request without raw contents must give each such input inline Contents
+// instead.
+func ValidateRawInputContents(req *triton.ModelInferRequest) error {
+	var rawInputs []string
+	for _, input := range req.Inputs {
+		if _, ok := input.Parameters["shared_memory_region"]; !ok {
+			rawInputs = append(rawInputs, input.Name)
+		}
+	}
+
+	if len(req.RawInputContents) == 0 {
+		for _, input := range req.Inputs {
+			if _, ok := input.Parameters["shared_memory_region"]; !ok && input.Contents == nil {
+				return fmt.Errorf("input %s has no contents", input.Name)
+			}
+		}
+		return nil
+	}
+	if len(req.RawInputContents) != len(rawInputs) {
+		return fmt.Errorf("request has %d raw input contents for %d non-shared-memory inputs %v",
+			len(req.RawInputContents), len(rawInputs), rawInputs)
+	}
+	return nil
+}
+
+// CheckResponseID returns an error unless resp carries the id of req. The
+// server echoes the request id, so checking it catches responses paired
+// with the wrong request when several are in flight, as with InferAsync
+// or a stream. A request without an id matches any response.
+func CheckResponseID(req *triton.ModelInferRequest, resp *triton.ModelInferResponse) error {
+	if req.Id != "" && resp.Id != req.Id {
+		return fmt.Errorf("response id %q does not match request id %q", resp.Id, req.Id)
+	}
+	return nil
+}
+
+// ValidateOutputs cross-checks every output of resp against the model's
+// metadata before it is decoded. Each output must be declared by the
+// model with the same datatype and a compatible shape, where a declared
+// dimension of -1 matches any size. Decoding an output under the wrong
+// datatype yields plausible-looking garbage rather than an error, so
+// checking first is cheap insurance.
+func ValidateOutputs(resp *triton.ModelInferResponse, metadata *triton.ModelMetadataResponse) error {
+	declared := make(map[string]*triton.ModelMetadataResponse_TensorMetadata, len(metadata.Outputs))
+	for _, output := range metadata.Outputs {
+		declared[output.Name] = output
+	}
+	for _, output := range resp.Outputs {
+		want, ok := declared[output.Name]
+		if !ok {
+			return fmt.Errorf("output %s is not declared by model %s", output.Name, metadata.Name)
+		}
+		if output.Datatype != want.Datatype {
+			return fmt.Errorf("output %s has datatype %s, model %s declares %s", output.Name, output.Datatype, metadata.Name, want.Datatype)
+		}
+		if !shapeMatches(output.Shape, want.Shape) {
+			return fmt.Errorf("output %s has shape %v, model %s declares %v", output.Name, output.Shape, metadata.Name, want.Shape)
+		}
+	}
+	return nil
+}
+
+// ValidateInputs checks every input of req against the model's metadata
+// before it is sent: each input must be declared by the model with the
+// same datatype and a compatible shape, where a declared dimension of -1
+// matches any size. The server rejects such requests anyway, but this
+// names the input and both shapes.
+func ValidateInputs(req *triton.ModelInferRequest, metadata *triton.ModelMetadataResponse) error {
+	declared := make(map[string]*triton.ModelMetadataResponse_TensorMetadata, len(metadata.Inputs))
+	for _, input := range metadata.Inputs {
+		declared[input.Name] = input
+	}
+	for _, input := range req.Inputs {
+		want, ok := declared[input.Name]
+		if !ok {
+			return fmt.Errorf("input %s is not declared by model %s", input.Name, metadata.Name)
+		}
+		if input.Datatype != want.Datatype {
+			return fmt.Errorf("%s expected datatype %s got %s", input.Name, want.Datatype, input.Datatype)
+		}
+		if !shapeMatches(input.Shape, want.Shape) {
+			return fmt.Errorf("%s expected %v got %v", input.Name, want.Shape, input.Shape)
+		}
+	}
+	return nil
+}
+
+// shapeMatches reports whether shape conforms to declared, in which -1
+// stands for a variable-size dimension.
+func shapeMatches(shape, declared []int64) bool {
+	if len(shape) != len(declared) {
+		return false
+	}
+	for i, dim := range declared {
+		if dim != -1 && dim != shape[i] {
+			return false
+		}
+	}
+	return true
+}
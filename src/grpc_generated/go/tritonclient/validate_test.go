@@ -0,0 +1,134 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestValidateRawInputContents(t *testing.T) {
+	shm := map[string]*triton.InferParameter{
+		"shared_memory_region": {ParameterChoice: &triton.InferParameter_StringParam{StringParam: "input_region"}},
+	}
+	tests := []struct {
+		name    string
+		req     *triton.ModelInferRequest
+		wantErr bool
+	}{
+		{
+			name: "one raw entry per input",
+			req: &triton.ModelInferRequest{
+				Inputs:           []*triton.ModelInferRequest_InferInputTensor{{Name: "INPUT0"}, {Name: "INPUT1"}},
+				RawInputContents: [][]byte{{1}, {2}},
+			},
+		},
+		{
+			name: "shared memory input needs no raw entry",
+			req: &triton.ModelInferRequest{
+				Inputs:           []*triton.ModelInferRequest_InferInputTensor{{Name: "INPUT0", Parameters: shm}, {Name: "INPUT1"}},
+				RawInputContents: [][]byte{{2}},
+			},
+		},
+		{
+			name: "inline contents",
+			req: &triton.ModelInferRequest{
+				Inputs: []*triton.ModelInferRequest_InferInputTensor{{Name: "INPUT0", Contents: &triton.InferTensorContents{IntContents: []int32{1}}}},
+			},
+		},
+		{
+			name: "missing raw entry",
+			req: &triton.ModelInferRequest{
+				Inputs:           []*triton.ModelInferRequest_InferInputTensor{{Name: "INPUT0"}, {Name: "INPUT1"}},
+				RawInputContents: [][]byte{{1}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no contents at all",
+			req: &triton.ModelInferRequest{
+				Inputs: []*triton.ModelInferRequest_InferInputTensor{{Name: "INPUT0"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		err := ValidateRawInputContents(tt.req)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ValidateRawInputContents() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCheckResponseID(t *testing.T) {
+	req := &triton.ModelInferRequest{Id: "42"}
+	if err := CheckResponseID(req, &triton.ModelInferResponse{Id: "42"}); err != nil {
+		t.Errorf("matching ids: %v", err)
+	}
+	if err := CheckResponseID(req, &triton.ModelInferResponse{Id: "41"}); err == nil {
+		t.Error("CheckResponseID accepted a mismatched id")
+	}
+	if err := CheckResponseID(&triton.ModelInferRequest{}, &triton.ModelInferResponse{Id: "7"}); err != nil {
+		t.Errorf("request without an id: %v", err)
+	}
+}
+
+func TestValidateOutputs(t *testing.T) {
+	metadata := &triton.ModelMetadataResponse{
+		Name: "simple",
+		Outputs: []*triton.ModelMetadataResponse_TensorMetadata{
+			{Name: "OUTPUT0", Datatype: "INT32", Shape: []int64{-1, 16}},
+			{Name: "OUTPUT1", Datatype: "INT32", Shape: []int64{-1, 16}},
+		},
+	}
+	output := func(name, datatype string, shape ...int64) *triton.ModelInferResponse {
+		return &triton.ModelInferResponse{
+			Outputs: []*triton.ModelInferResponse_InferOutputTensor{
+				{Name: name, Datatype: datatype, Shape: shape},
+			},
+		}
+	}
+
+	if err := ValidateOutputs(output("OUTPUT0", "INT32", 2, 16), metadata); err != nil {
+		t.Errorf("matching output: %v", err)
+	}
+	tests := []struct {
+		name string
+		resp *triton.ModelInferResponse
+	}{
+		{"undeclared output", output("OUTPUT2", "INT32", 1, 16)},
+		{"wrong datatype", output("OUTPUT0", "FP32", 1, 16)},
+		{"wrong dimension", output("OUTPUT0", "INT32", 1, 8)},
+		{"wrong rank", output("OUTPUT0", "INT32", 16)},
+	}
+	for _, tt := range tests {
+		if err := ValidateOutputs(tt.resp, metadata); err == nil {
+			t.Errorf("%s: ValidateOutputs succeeded, want error", tt.name)
+		}
+	}
+}
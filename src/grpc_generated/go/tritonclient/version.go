@@ -0,0 +1,94 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ModelVersion selects either the latest version of a model, as decided
+// by the model's version policy, or one specific version. Its String
+// form is what the API's version arguments expect.
+type ModelVersion struct {
+	n int64
+}
+
+// Latest selects the version the server considers latest.
+func Latest() ModelVersion {
+	return ModelVersion{}
+}
+
+// Specific selects version n. It panics if n is not positive; use
+// ParseModelVersion for versions that aren't known to be valid.
+func Specific(n int64) ModelVersion {
+	if n <= 0 {
+		panic(fmt.Sprintf("tritonclient: invalid model version %d, must be positive", n))
+	}
+	return ModelVersion{n: n}
+}
+
+// IsLatest reports whether v selects the latest version.
+func (v ModelVersion) IsLatest() bool {
+	return v.n == 0
+}
+
+// String returns "" for the latest version and the decimal version number
+// otherwise.
+func (v ModelVersion) String() string {
+	if v.n == 0 {
+		return ""
+	}
+	return strconv.FormatInt(v.n, 10)
+}
+
+// ParseModelVersion parses a version argument: "" for the latest version
+// or a positive decimal number. Surrounding spaces and leading zeros are
+// accepted.
+func ParseModelVersion(s string) (ModelVersion, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Latest(), nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n <= 0 || strings.HasPrefix(s, "+") {
+		return ModelVersion{}, fmt.Errorf("invalid model version %q: want a positive number, or empty for the latest", s)
+	}
+	return Specific(n), nil
+}
+
+// normalizeVersion validates a version argument and returns it in the
+// canonical form sent to the server, so "01" and "1" address, and cache,
+// the same version.
+func normalizeVersion(version string) (string, error) {
+	v, err := ParseModelVersion(version)
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2026, NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//  * Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+//  * Neither the name of NVIDIA CORPORATION nor the names of its
+//    contributors may be used to endorse or promote products derived
+//    from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS ``AS IS'' AND ANY
+// EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR
+// PURPOSE ARE DISCLAIMED.  IN NO EVENT SHALL THE COPYRIGHT OWNER OR
+// CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL,
+// EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+// PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR
+// PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+// OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tritonclient
+
+import (
+	"context"
+	"testing"
+
+	triton "nvidia_inferenceserver"
+)
+
+func TestModelVersion(t *testing.T) {
+	if v := Latest(); v.String() != "" || !v.IsLatest() {
+		t.Errorf("Latest() = %q", v)
+	}
+	if v := Specific(3); v.String() != "3" || v.IsLatest() {
+		t.Errorf("Specific(3) = %q", v)
+	}
+	for _, n := range []int64{0, -3} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Specific(%d) didn't panic", n)
+				}
+			}()
+			Specific(n)
+		}()
+	}
+
+	valid := map[string]string{"": "", " ": "", "1": "1", "007": "7", " 12 ": "12"}
+	for in, want := range valid {
+		v, err := ParseModelVersion(in)
+		if err != nil || v.String() != want {
+			t.Errorf("ParseModelVersion(%q) = %q, %v; want %q", in, v, err, want)
+		}
+	}
+	for _, in := range []string{"latest", "0", "-1", "+1", "1.0", "v1"} {
+		if _, err := ParseModelVersion(in); err == nil {
+			t.Errorf("ParseModelVersion(%q) succeeded", in)
+		}
+	}
+}
+
+func TestModelVersionNormalized(t *testing.T) {
+	var versions []string
+	client := startFakeServer(t, &fakeServer{
+		modelReady: func(_ context.Context, req *triton.ModelReadyRequest) (*triton.ModelReadyResponse, error) {
+			versions = append(versions, req.Version)
+			return &triton.ModelReadyResponse{Ready: true}, nil
+		},
+	})
+	ctx := context.Background()
+
+	client.ModelReady(ctx, "simple", "01")
+	client.ModelReady(ctx, "simple", Latest().String())
+	if len(versions) != 2 || versions[0] != "1" || versions[1] != "" {
+		t.Errorf("server saw versions %q, want [\"1\" \"\"]", versions)
+	}
+	if _, err := client.ModelReady(ctx, "simple", "latest"); err == nil {
+		t.Error("ModelReady accepted version \"latest\"")
+	}
+	if len(versions) != 2 {
+		t.Error("an invalid version reached the server")
+	}
+}
+
+func TestInferVersionNormalized(t *testing.T) {
+	var versions []string
+	client := startFakeServer(t, &fakeServer{
+		modelInfer: func(_ context.Context, req *triton.ModelInferRequest) (*triton.ModelInferResponse, error) {
+			versions = append(versions, req.ModelVersion)
+			return &triton.ModelInferResponse{Id: req.Id}, nil
+		},
+	})
+
+	req := &triton.ModelInferRequest{ModelName: "simple", ModelVersion: "01", Id: "n"}
+	resp, err := client.Infer(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Infer: %v", err)
+	}
+	if resp.Id != "n" {
+		t.Errorf("response id = %q, want the request's", resp.Id)
+	}
+	if len(versions) != 1 || versions[0] != "1" {
+		t.Errorf("server saw versions %q, want [\"1\"]", versions)
+	}
+	if req.ModelVersion != "01" {
+		t.Errorf("caller's request version changed to %q", req.ModelVersion)
+	}
+	if _, err := client.Infer(context.Background(), &triton.ModelInferRequest{ModelName: "simple", ModelVersion: "latest"}); err == nil {
+		t.Error("Infer accepted version \"latest\"")
+	}
+}